@@ -0,0 +1,167 @@
+package ncloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// apiError is an error of the NCP API decoded from the SDK error. e.g.
+// Status: 400 Bad Request, Body: {"responseError": {"returnCode": "800", "returnMessage": "..."}}
+type apiError struct {
+	// Prefix is the message added in front of the SDK error by the provider, if any
+	Prefix        string
+	Status        string
+	StatusCode    int
+	ReturnCode    string
+	ReturnMessage string
+	RequestId     string
+}
+
+// apiErrorExplanation describes a well-known API error and how to resolve it.
+type apiErrorExplanation struct {
+	Summary string
+	Hint    string
+	// Attribute is the argument which usually causes the error
+	Attribute string
+}
+
+var (
+	apiErrorPermission = &apiErrorExplanation{
+		Summary: "Permission denied",
+		Hint:    "The API key has no permission for this action. Check the policies of the sub account in Sub Account > Policies, or use the API key of the main account.",
+	}
+	apiErrorQuotaExceeded = &apiErrorExplanation{
+		Summary: "Quota exceeded",
+		Hint:    "The maximum number of this resource in the account has been reached. Delete unused resources or request a quota increase from the NCP console.",
+	}
+	apiErrorNameInUse = &apiErrorExplanation{
+		Summary:   "Name already in use",
+		Hint:      "Choose another name, or import the existing resource with `terraform import`.",
+		Attribute: "name",
+	}
+	apiErrorObjectInOperation = &apiErrorExplanation{
+		Summary: "Object in operation",
+		Hint:    "The resource or a related resource is being changed by another request. Wait until the operation is complete and apply again.",
+	}
+)
+
+// apiErrorExplanations maps well-known return codes to their explanation.
+var apiErrorExplanations = map[string]*apiErrorExplanation{
+	ApiErrorAuthorityParameter:                           apiErrorPermission,
+	ApiErrorObjectInOperation:                            apiErrorObjectInOperation,
+	ApiErrorPortForwardingObjectInOperation:              apiErrorObjectInOperation,
+	ApiErrorServerObjectInOperation:                      apiErrorObjectInOperation,
+	ApiErrorServerObjectInOperation2:                     apiErrorObjectInOperation,
+	ApiErrorPreviousServersHaveNotBeenEntirelyTerminated: apiErrorObjectInOperation,
+	ApiErrorAcgCantChangeSameTime:                        apiErrorObjectInOperation,
+	ApiErrorNetworkAclRuleChangeIngRules:                 apiErrorObjectInOperation,
+	ApiErrorASGScalingIsActive:                           apiErrorObjectInOperation,
+}
+
+// Explanation returns the explanation of the error, or nil if it is not a well-known one.
+// Quota and duplicated name errors have different return codes for each service, so they are detected by the message.
+func (e *apiError) Explanation() *apiErrorExplanation {
+	if explanation, ok := apiErrorExplanations[e.ReturnCode]; ok {
+		return explanation
+	}
+
+	message := strings.ToLower(e.ReturnMessage)
+	switch {
+	case strings.Contains(message, "quota") ||
+		strings.Contains(message, "exceed") && (strings.Contains(message, "limit") || strings.Contains(message, "maximum") || strings.Contains(message, "number")):
+		return apiErrorQuotaExceeded
+	case strings.Contains(message, "name") && (strings.Contains(message, "already") || strings.Contains(message, "duplicat") || strings.Contains(message, "in use")):
+		return apiErrorNameInUse
+	}
+
+	return nil
+}
+
+// Detail returns the decoded fields of the error and the remediation hint, if any.
+func (e *apiError) Detail() string {
+	detail := fmt.Sprintf("returnCode: %s, returnMessage: %s\nHTTP status: %s", e.ReturnCode, e.ReturnMessage, e.Status)
+	if e.RequestId != "" {
+		detail += fmt.Sprintf("\nrequestId: %s", e.RequestId)
+	}
+	if explanation := e.Explanation(); explanation != nil {
+		detail += "\n\n" + explanation.Hint
+	}
+
+	return detail
+}
+
+// Summary returns a short description of the error, prefixed like the original error.
+func (e *apiError) Summary() string {
+	summary := e.ReturnMessage
+	if explanation := e.Explanation(); explanation != nil {
+		summary = explanation.Summary
+		if e.ReturnMessage != "" {
+			summary += ": " + e.ReturnMessage
+		}
+	}
+	if summary == "" {
+		summary = e.Status
+	}
+
+	if e.Prefix != "" {
+		return e.Prefix + ": " + summary
+	}
+	return summary
+}
+
+// decodeApiError decodes err returned by the NCP SDK. It returns false if err is not an error response of the API.
+func decodeApiError(err error) (*apiError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	sa := strings.SplitN(err.Error(), "Body: ", 2)
+	if len(sa) != 2 {
+		return nil, false
+	}
+
+	var body struct {
+		ResponseError map[string]interface{} `json:"responseError"`
+		RequestId     interface{}            `json:"requestId"`
+	}
+	if err := json.Unmarshal([]byte(sa[1]), &body); err != nil || body.ResponseError == nil {
+		return nil, false
+	}
+
+	e := &apiError{
+		ReturnCode:    jsonString(body.ResponseError["returnCode"]),
+		ReturnMessage: jsonString(body.ResponseError["returnMessage"]),
+		RequestId:     jsonString(body.ResponseError["requestId"]),
+	}
+	if e.RequestId == "" {
+		e.RequestId = jsonString(body.RequestId)
+	}
+
+	head := sa[0]
+	if i := strings.LastIndex(head, "Status: "); i >= 0 {
+		e.Prefix = strings.TrimRight(strings.TrimSpace(head[:i]), ":")
+		head = head[i+len("Status: "):]
+	}
+	e.Status = strings.TrimSuffix(strings.TrimSpace(head), ",")
+	if fields := strings.Fields(e.Status); len(fields) > 0 {
+		e.StatusCode, _ = strconv.Atoi(fields[0])
+	}
+
+	return e, true
+}
+
+// jsonString returns v decoded from JSON as string. Some APIs return the codes as number.
+func jsonString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
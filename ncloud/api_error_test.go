@@ -0,0 +1,99 @@
+package ncloud
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDecodeApiError(t *testing.T) {
+	err := fmt.Errorf(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "800", "returnMessage": "You do not have permission.", "requestId": "a1b2c3"}}`)
+
+	apiErr, ok := decodeApiError(err)
+
+	if !ok {
+		t.Fatal("Expected API error")
+	}
+	if apiErr.StatusCode != 400 || apiErr.Status != "400 Bad Request" {
+		t.Fatalf("Expected status 400 Bad Request, Actual: %d %s", apiErr.StatusCode, apiErr.Status)
+	}
+	if apiErr.ReturnCode != ApiErrorAuthorityParameter || apiErr.ReturnMessage != "You do not have permission." || apiErr.RequestId != "a1b2c3" {
+		t.Fatalf("Unexpected fields: %#v", apiErr)
+	}
+	if apiErr.Prefix != "" {
+		t.Fatalf("Expected no prefix, Actual: %s", apiErr.Prefix)
+	}
+}
+
+func TestDecodeApiError_numberReturnCode(t *testing.T) {
+	err := fmt.Errorf(`getServerInstanceList: Status: 500 Internal Server Error, Body: {"responseError": {"returnCode": 25013, "returnMessage": "in operation"}, "requestId": "r-1"}`)
+
+	apiErr, ok := decodeApiError(err)
+
+	if !ok {
+		t.Fatal("Expected API error")
+	}
+	if apiErr.ReturnCode != ApiErrorObjectInOperation || apiErr.RequestId != "r-1" || apiErr.StatusCode != 500 {
+		t.Fatalf("Unexpected fields: %#v", apiErr)
+	}
+	if apiErr.Prefix != "getServerInstanceList" {
+		t.Fatalf("Expected: getServerInstanceList, Actual: %s", apiErr.Prefix)
+	}
+}
+
+func TestDecodeApiError_notApiError(t *testing.T) {
+	for _, err := range []error{
+		nil,
+		errors.New("not found subnet"),
+		fmt.Errorf(`Status: 502 Bad Gateway, Body: <html></html>`),
+		fmt.Errorf(`Status: 400 Bad Request, Body: {"message": "unexpected"}`),
+	} {
+		if _, ok := decodeApiError(err); ok {
+			t.Fatalf("Expected %v not to be decoded", err)
+		}
+	}
+}
+
+func TestApiErrorExplanation(t *testing.T) {
+	cases := []struct {
+		returnCode    string
+		returnMessage string
+		expected      *apiErrorExplanation
+	}{
+		{ApiErrorAuthorityParameter, "Not authorized.", apiErrorPermission},
+		{ApiErrorServerObjectInOperation2, "Server is being changed.", apiErrorObjectInOperation},
+		{ApiErrorAcgCantChangeSameTime, "ACG is being changed.", apiErrorObjectInOperation},
+		{"1000050", "You have exceeded the maximum number of VPCs.", apiErrorQuotaExceeded},
+		{"1000051", "Quota limit reached.", apiErrorQuotaExceeded},
+		{"1000030", "The name is already in use.", apiErrorNameInUse},
+		{"1000031", "Duplicated subnet name.", apiErrorNameInUse},
+		{ApiErrorUnknown, "Unknown error.", nil},
+	}
+
+	for _, c := range cases {
+		apiErr := &apiError{ReturnCode: c.returnCode, ReturnMessage: c.returnMessage}
+		if explanation := apiErr.Explanation(); explanation != c.expected {
+			t.Fatalf("%s %s: Expected: %#v, Actual: %#v", c.returnCode, c.returnMessage, c.expected, explanation)
+		}
+	}
+}
+
+func TestApiErrorDetail(t *testing.T) {
+	apiErr := &apiError{
+		Status:        "400 Bad Request",
+		ReturnCode:    ApiErrorAuthorityParameter,
+		ReturnMessage: "Not authorized.",
+		RequestId:     "a1b2c3",
+	}
+
+	detail := apiErr.Detail()
+
+	expected := "returnCode: 800, returnMessage: Not authorized.\nHTTP status: 400 Bad Request\nrequestId: a1b2c3\n\n"
+	if !strings.HasPrefix(detail, expected) || !strings.HasSuffix(detail, apiErrorPermission.Hint) {
+		t.Fatalf("Unexpected detail: %s", detail)
+	}
+	if summary := apiErr.Summary(); summary != "Permission denied: Not authorized." {
+		t.Fatalf("Expected: Permission denied: Not authorized., Actual: %s", summary)
+	}
+}
@@ -1,7 +1,6 @@
 package ncloud
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 
@@ -36,12 +35,21 @@ const (
 const (
 	InstanceStatusInit        = "INIT"
 	InstanceStatusCreate      = "CREATING"
+	InstanceStatusCreated     = "CREAT"
 	InstanceStatusRunning     = "RUN"
+	InstanceStatusStopped     = "NSTOP"
 	InstanceStatusSetting     = "SET"
 	InstanceStatusTerminating = "TERMTING"
+	InstanceStatusTerminate   = "TERMT"
 	InstanceStatusTerminated  = "TERMINATED"
 )
 
+const (
+	InstanceOperationNull   = "NULL"
+	InstanceOperationSetup  = "SETUP"
+	InstanceOperationChange = "CHNG"
+)
+
 const (
 	BYTE = 1 << (10 * iota)
 	KILOBYTE
@@ -68,17 +76,21 @@ type CommonError struct {
 }
 
 func logErrorResponse(tag string, err error, args interface{}) {
-	param, _ := json.Marshal(args)
+	param := redactedJSON(args)
+	if apiErr, ok := decodeApiError(err); ok {
+		log.Printf("[ERROR] %s error params=%s, status=%s, returnCode=%s, returnMessage=%s, requestId=%s", tag, param, apiErr.Status, apiErr.ReturnCode, apiErr.ReturnMessage, apiErr.RequestId)
+		return
+	}
 	log.Printf("[ERROR] %s error params=%s, err=%s", tag, param, err)
 }
 
 func logCommonRequest(tag string, args interface{}) {
-	param, _ := json.Marshal(args)
+	param := redactedJSON(args)
 	log.Printf("[INFO] %s params=%s", tag, param)
 }
 
 func logResponse(tag string, args interface{}) {
-	resp, _ := json.Marshal(args)
+	resp := redactedJSON(args)
 	log.Printf("[INFO] %s response=%s", tag, resp)
 }
 
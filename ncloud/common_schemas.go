@@ -49,3 +49,12 @@ var regionSchemaResource = &schema.Resource{
 		},
 	},
 }
+
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Map of tags assigned to the resource, including the provider `default_tags`",
+	}
+}
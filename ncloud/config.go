@@ -1,6 +1,12 @@
 package ncloud
 
 import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
@@ -28,10 +34,50 @@ const DefaultCreateTimeout = 1 * time.Hour
 const DefaultUpdateTimeout = 10 * time.Minute
 const DefaultStopTimeout = 5 * time.Minute
 
+// apiGatewayBySite is the API gateway of each site except "public"
+var apiGatewayBySite = map[string]string{
+	"gov": "https://ncloud.apigw.gov-ntruss.com",
+	"fin": "https://fin-ncloud.apigw.fin-ntruss.com",
+}
+
+// endpointServiceNames is the list of services whose base path can be set in the `endpoints` block
+var endpointServiceNames = []string{
+	"server",
+	"autoscaling",
+	"loadbalancer",
+	"cdn",
+	"clouddb",
+	"monitoring",
+	"vpc",
+	"vserver",
+	"vnas",
+	"vautoscaling",
+	"vloadbalancer",
+	"vnks",
+}
+
 type Config struct {
-	AccessKey string
-	SecretKey string
-	Region    string
+	AccessKey             string
+	SecretKey             string
+	Profile               string
+	SharedCredentialsFile string
+	Region                string
+	Site                  string
+	Endpoints             map[string]string
+	MaxRetries            int
+	RetryMaxBackoff       time.Duration
+	// MaxRequestsPerSecond limits the rate of the API calls of each service. 0 means no limit
+	MaxRequestsPerSecond int
+	// MaxConcurrentMutations limits the number of mutating API calls in flight of each service. 0 means no limit
+	MaxConcurrentMutations int
+	// ReadOnly blocks every API call which may change resources
+	ReadOnly bool
+	// SkipCredentialsValidation defers the error of resolving the credentials until the first API call
+	SkipCredentialsValidation bool
+
+	credentialsErr error
+	// Transport is the base transport of the SDK clients. http.DefaultTransport is used if nil.
+	Transport http.RoundTripper
 }
 
 type NcloudAPIClient struct {
@@ -50,30 +96,135 @@ type NcloudAPIClient struct {
 }
 
 func (c *Config) Client() (*NcloudAPIClient, error) {
+	accessKey, secretKey, err := c.resolveCredentials()
+	if err != nil {
+		if !c.SkipCredentialsValidation {
+			return nil, err
+		}
+		log.Printf("[WARN] %s", err)
+		c.credentialsErr = err
+	}
+
 	apiKey := &ncloud.APIKey{
-		AccessKey: c.AccessKey,
-		SecretKey: c.SecretKey,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
 	}
 	return &NcloudAPIClient{
-		server:        server.NewAPIClient(server.NewConfiguration(apiKey)),
-		autoscaling:   autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		loadbalancer:  loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		cdn:           cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		clouddb:       clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		monitoring:    monitoring.NewAPIClient(monitoring.NewConfiguration(apiKey)),
-		vpc:           vpc.NewAPIClient(vpc.NewConfiguration(apiKey)),
-		vserver:       vserver.NewAPIClient(vserver.NewConfiguration(apiKey)),
-		vnas:          vnas.NewAPIClient(vnas.NewConfiguration(apiKey)),
-		vautoscaling:  vautoscaling.NewAPIClient(vautoscaling.NewConfiguration(apiKey)),
-		vloadbalancer: vloadbalancer.NewAPIClient(vloadbalancer.NewConfiguration(apiKey)),
-		vnks:          vnks.NewAPIClient(vnks.NewConfiguration(c.Region, apiKey)),
+		server:        server.NewAPIClient(c.configuration("server", server.NewConfiguration(apiKey))),
+		autoscaling:   autoscaling.NewAPIClient(c.configuration("autoscaling", autoscaling.NewConfiguration(apiKey))),
+		loadbalancer:  loadbalancer.NewAPIClient(c.configuration("loadbalancer", loadbalancer.NewConfiguration(apiKey))),
+		cdn:           cdn.NewAPIClient(c.configuration("cdn", cdn.NewConfiguration(apiKey))),
+		clouddb:       clouddb.NewAPIClient(c.configuration("clouddb", clouddb.NewConfiguration(apiKey))),
+		monitoring:    monitoring.NewAPIClient(c.configuration("monitoring", monitoring.NewConfiguration(apiKey))),
+		vpc:           vpc.NewAPIClient(c.configuration("vpc", vpc.NewConfiguration(apiKey))),
+		vserver:       vserver.NewAPIClient(c.configuration("vserver", vserver.NewConfiguration(apiKey))),
+		vnas:          vnas.NewAPIClient(c.configuration("vnas", vnas.NewConfiguration(apiKey))),
+		vautoscaling:  vautoscaling.NewAPIClient(c.configuration("vautoscaling", vautoscaling.NewConfiguration(apiKey))),
+		vloadbalancer: vloadbalancer.NewAPIClient(c.configuration("vloadbalancer", vloadbalancer.NewConfiguration(apiKey))),
+		vnks:          vnks.NewAPIClient(c.configuration("vnks", vnks.NewConfiguration(c.Region, apiKey))),
 	}, nil
 }
 
+// configuration sets the base path of the SDK configuration from the `endpoints` block or the site's API gateway,
+// and the HTTP client which every API call of the service goes through.
+func (c *Config) configuration(service string, cfg *ncloud.Configuration) *ncloud.Configuration {
+	if endpoint := c.Endpoints[service]; endpoint != "" {
+		cfg.BasePath = strings.TrimSuffix(endpoint, "/")
+	} else if apiGateway, ok := apiGatewayBySite[c.Site]; ok {
+		if service == "vnks" {
+			cfg.BasePath = vnksBasePath(apiGateway, c.Region)
+		} else {
+			cfg.BasePath = fmt.Sprintf("%s/%s/v2", apiGateway, service)
+		}
+	}
+
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if c.credentialsErr != nil {
+		transport = &credentialsErrorTransport{err: c.credentialsErr}
+	}
+
+	transport = newRateLimitTransport(transport, service, c.MaxRequestsPerSecond, c.MaxConcurrentMutations)
+	transport = newRetryTransport(transport, service, cfg.Credentials, c.MaxRetries, c.RetryMaxBackoff)
+	if c.ReadOnly {
+		transport = newReadOnlyTransport(transport, service)
+	}
+
+	cfg.HTTPClient = &http.Client{
+		Transport: transport,
+	}
+
+	return cfg
+}
+
+// vnksBasePath returns the base path of NKS which is served by a separate gateway for each region
+func vnksBasePath(apiGateway string, region string) string {
+	apiGateway = strings.Replace(apiGateway, "https://ncloud.", "https://nks.", 1)
+	apiGateway = strings.Replace(apiGateway, "https://fin-ncloud.", "https://nks.", 1)
+
+	switch region {
+	case "KR":
+		return fmt.Sprintf("%s/vnks/v2", apiGateway)
+	case "FKR":
+		return fmt.Sprintf("%s/nks/v2", apiGateway)
+	default:
+		return fmt.Sprintf("%s/vnks/%s-v2", apiGateway, strings.ToLower(region))
+	}
+}
+
 type ProviderConfig struct {
-	Site       string
-	SupportVPC bool
-	RegionCode string
-	RegionNo   string
-	Client     *NcloudAPIClient
+	Site        string
+	SupportVPC  bool
+	RegionCode  string
+	RegionNo    string
+	DefaultTags map[string]string
+	Client      *NcloudAPIClient
+
+	// Region and zone caches are scoped to the provider so that aliased providers don't share them
+	cacheMutex        sync.RWMutex
+	regionCacheByCode map[string]Region
+	zoneCache         map[string]string
+
+	regionLoadMutex   sync.Mutex
+	regionCacheLoaded bool
+
+	readCache readCache
+}
+
+func (c *ProviderConfig) getRegionCache(code string) (Region, bool) {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+
+	region, ok := c.regionCacheByCode[code]
+	return region, ok
+}
+
+func (c *ProviderConfig) setRegionCache(region Region) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.regionCacheByCode == nil {
+		c.regionCacheByCode = make(map[string]Region)
+	}
+	c.regionCacheByCode[*region.RegionCode] = region
+}
+
+func (c *ProviderConfig) getZoneCache(key string) string {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+
+	return c.zoneCache[key]
+}
+
+func (c *ProviderConfig) setZoneCache(key string, value string) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.zoneCache == nil {
+		c.zoneCache = make(map[string]string)
+	}
+	c.zoneCache[key] = value
 }
@@ -0,0 +1,65 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestConfigConfiguration_endpoint(t *testing.T) {
+	config := &Config{
+		Region: "KR",
+		Site:   "gov",
+		Endpoints: map[string]string{
+			"vserver": "http://localhost:8080/vserver/v2/",
+		},
+	}
+
+	cfg := config.configuration("vserver", vserver.NewConfiguration())
+	if cfg.BasePath != "http://localhost:8080/vserver/v2" {
+		t.Fatalf("Expected endpoint to override the base path, got %s", cfg.BasePath)
+	}
+}
+
+func TestConfigConfiguration_site(t *testing.T) {
+	config := &Config{
+		Region: "KR",
+		Site:   "gov",
+	}
+
+	cfg := config.configuration("vserver", vserver.NewConfiguration())
+	if cfg.BasePath != "https://ncloud.apigw.gov-ntruss.com/vserver/v2" {
+		t.Fatalf("Expected gov gateway base path, got %s", cfg.BasePath)
+	}
+
+	cfg = config.configuration("vnks", vnks.NewConfiguration(config.Region))
+	if cfg.BasePath != "https://nks.apigw.gov-ntruss.com/vnks/v2" {
+		t.Fatalf("Expected gov NKS gateway base path, got %s", cfg.BasePath)
+	}
+}
+
+func TestConfigConfiguration_public(t *testing.T) {
+	config := &Config{
+		Region: "KR",
+	}
+
+	cfg := config.configuration("vserver", vserver.NewConfiguration())
+	if cfg.BasePath != "https://ncloud.apigw.ntruss.com/vserver/v2" {
+		t.Fatalf("Expected default base path, got %s", cfg.BasePath)
+	}
+}
+
+func TestVnksBasePath(t *testing.T) {
+	cases := map[string]string{
+		"KR":  "https://nks.apigw.fin-ntruss.com/vnks/v2",
+		"FKR": "https://nks.apigw.fin-ntruss.com/nks/v2",
+		"SGN": "https://nks.apigw.fin-ntruss.com/vnks/sgn-v2",
+	}
+
+	for region, expected := range cases {
+		if basePath := vnksBasePath(apiGatewayBySite["fin"], region); basePath != expected {
+			t.Fatalf("Expected %s for region %s, got %s", expected, region, basePath)
+		}
+	}
+}
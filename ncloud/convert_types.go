@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"reflect"
-	"strings"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 )
@@ -51,27 +50,18 @@ func GetCommonResponse(i interface{}) *CommonResponse {
 	}
 }
 
-//GetCommonErrorBody parse common error message
+//GetCommonErrorBody parse common error message.
+//The empty CommonError is returned with the error for the errors without the body, e.g. the calls blocked by `read_only`,
+//so that the callers checking ReturnCode don't need to check nil.
 func GetCommonErrorBody(err error) (*CommonError, error) {
-	sa := strings.Split(err.Error(), "Body: ")
-	var errMsg string
-
-	if len(sa) != 2 {
-		return nil, fmt.Errorf("error body is incorrect: %s", err)
-	}
-
-	errMsg = sa[1]
-
-	var m map[string]interface{}
-	if err := json.Unmarshal([]byte(errMsg), &m); err != nil {
-		return nil, err
+	apiErr, ok := decodeApiError(err)
+	if !ok {
+		return &CommonError{}, fmt.Errorf("error body is incorrect: %s", err)
 	}
 
-	e := m["responseError"].(map[string]interface{})
-
 	return &CommonError{
-		ReturnCode:    e["returnCode"].(string),
-		ReturnMessage: e["returnMessage"].(string),
+		ReturnCode:    apiErr.ReturnCode,
+		ReturnMessage: apiErr.ReturnMessage,
 	}, nil
 }
 
@@ -68,6 +68,18 @@ func TestGetCommonErrorBody(t *testing.T) {
 
 }
 
+func TestGetCommonErrorBody_withoutResponseError(t *testing.T) {
+	err := fmt.Errorf(`Status: 500 Internal Server Error, Body: {"message": "unexpected"}`)
+
+	e, err := GetCommonErrorBody(err)
+	if err == nil {
+		t.Fatal("Expected error but got nil")
+	}
+	if e == nil || e.ReturnCode != "" {
+		t.Fatalf("Expected empty error body but got %#v", e)
+	}
+}
+
 func TestConvertToMap(t *testing.T) {
 	i := &ServerInstance{
 		ZoneNo:                     ncloud.String("KR-1"),
@@ -0,0 +1,135 @@
+package ncloud
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	DefaultProfile = "default"
+
+	sharedCredentialsAccessKey = "ncloud_access_key_id"
+	sharedCredentialsSecretKey = "ncloud_secret_access_key"
+)
+
+// resolveCredentials returns the first complete key pair of static keys, environment variables and the shared credentials file.
+// Static keys and environment variables are already merged by the schema default functions.
+func (c *Config) resolveCredentials() (string, string, error) {
+	if c.AccessKey != "" && c.SecretKey != "" {
+		return c.AccessKey, c.SecretKey, nil
+	}
+
+	filename := c.SharedCredentialsFile
+	if filename == "" {
+		filename = defaultSharedCredentialsFilename()
+	}
+
+	profile := c.Profile
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	accessKey, secretKey, err := loadSharedCredentials(filename, profile)
+	if err != nil {
+		return "", "", fmt.Errorf("no valid credentials found. set `access_key` and `secret_key`, `NCLOUD_ACCESS_KEY` and `NCLOUD_SECRET_KEY`, or a `profile` in the shared credentials file: %s", err)
+	}
+
+	log.Printf("[INFO] Using credentials of profile `%s` in %s", profile, filename)
+	return accessKey, secretKey, nil
+}
+
+func defaultSharedCredentialsFilename() string {
+	return filepath.Join(userHomeDir(), ".ncloud", "configure")
+}
+
+func userHomeDir() string {
+	if runtime.GOOS == "windows" {
+		return os.Getenv("USERPROFILE")
+	}
+
+	return os.Getenv("HOME")
+}
+
+// loadSharedCredentials reads access/secret key of the profile from the shared credentials file.
+// Profiles are written as `[name]` sections and keys before the first section belong to the "default" profile,
+// so the file written by the ncloud CLI keeps working as is. Profile names are case-insensitive as the CLI writes `[DEFAULT]`.
+func loadSharedCredentials(filename string, profile string) (string, string, error) {
+	if strings.HasPrefix(filename, "~/") {
+		filename = filepath.Join(userHomeDir(), filename[2:])
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	var accessKey, secretKey string
+	found := false
+	currentProfile := DefaultProfile
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			currentProfile = strings.TrimSpace(strings.TrimPrefix(line[1:len(line)-1], "profile "))
+			continue
+		}
+
+		if !strings.EqualFold(currentProfile, profile) {
+			continue
+		}
+
+		s := strings.SplitN(line, "=", 2)
+		if len(s) != 2 {
+			continue
+		}
+
+		switch strings.TrimSpace(s[0]) {
+		case sharedCredentialsAccessKey:
+			accessKey = strings.TrimSpace(s[1])
+			found = true
+		case sharedCredentialsSecretKey:
+			secretKey = strings.TrimSpace(s[1])
+			found = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", "", err
+	}
+
+	if !found {
+		return "", "", fmt.Errorf("profile `%s` not found in %s", profile, filename)
+	}
+
+	if accessKey == "" || secretKey == "" {
+		return "", "", fmt.Errorf("profile `%s` in %s must have both %s and %s", profile, filename, sharedCredentialsAccessKey, sharedCredentialsSecretKey)
+	}
+
+	return accessKey, secretKey, nil
+}
+
+// credentialsErrorTransport fails every API call with the error of resolving the credentials.
+// It is used when `skip_credentials_validation` defers the error until a resource actually calls the API.
+type credentialsErrorTransport struct {
+	err error
+}
+
+func (t *credentialsErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	return nil, t.err
+}
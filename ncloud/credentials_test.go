@@ -0,0 +1,124 @@
+package ncloud
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSharedCredentials = `ncloud_access_key_id = default-access-key
+ncloud_secret_access_key = default-secret-key
+
+[dev]
+ncloud_access_key_id = dev-access-key
+ncloud_secret_access_key = dev-secret-key
+
+[profile prod]
+ncloud_access_key_id=prod-access-key
+ncloud_secret_access_key=prod-secret-key
+
+[broken]
+ncloud_access_key_id = broken-access-key
+`
+
+// testCliSharedCredentials is the file written by `ncloud configure` of the ncloud CLI
+const testCliSharedCredentials = `[DEFAULT]
+ncloud_access_key_id = cli-access-key
+ncloud_secret_access_key = cli-secret-key
+ncloud_api_url = https://ncloud.apigw.ntruss.com
+`
+
+func testWriteSharedCredentials(t *testing.T) string {
+	return testWriteSharedCredentialsContent(t, testSharedCredentials)
+}
+
+func testWriteSharedCredentialsContent(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "ncloud-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	filename := filepath.Join(dir, "configure")
+	if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestLoadSharedCredentials(t *testing.T) {
+	filename := testWriteSharedCredentials(t)
+
+	cases := map[string][]string{
+		"default": {"default-access-key", "default-secret-key"},
+		"dev":     {"dev-access-key", "dev-secret-key"},
+		"prod":    {"prod-access-key", "prod-secret-key"},
+	}
+
+	for profile, expected := range cases {
+		accessKey, secretKey, err := loadSharedCredentials(filename, profile)
+		if err != nil {
+			t.Fatalf("Unexpected error for profile %s: %s", profile, err)
+		}
+		if accessKey != expected[0] || secretKey != expected[1] {
+			t.Fatalf("Expected %v for profile %s, got [%s %s]", expected, profile, accessKey, secretKey)
+		}
+	}
+}
+
+func TestLoadSharedCredentials_cliDefaultSection(t *testing.T) {
+	filename := testWriteSharedCredentialsContent(t, testCliSharedCredentials)
+
+	accessKey, secretKey, err := loadSharedCredentials(filename, DefaultProfile)
+	if err != nil {
+		t.Fatalf("Unexpected error for [DEFAULT] section: %s", err)
+	}
+	if accessKey != "cli-access-key" || secretKey != "cli-secret-key" {
+		t.Fatalf("Expected the keys of [DEFAULT] section, got [%s %s]", accessKey, secretKey)
+	}
+
+	config := &Config{SharedCredentialsFile: filename}
+	if accessKey, _, err := config.resolveCredentials(); err != nil || accessKey != "cli-access-key" {
+		t.Fatalf("Expected the default profile to resolve [DEFAULT] section, got %s, %v", accessKey, err)
+	}
+}
+
+func TestLoadSharedCredentials_invalidProfile(t *testing.T) {
+	filename := testWriteSharedCredentials(t)
+
+	if _, _, err := loadSharedCredentials(filename, "unknown"); err == nil {
+		t.Fatalf("Unknown profile must throw error")
+	}
+
+	if _, _, err := loadSharedCredentials(filename, "broken"); err == nil {
+		t.Fatalf("Profile without secret key must throw error")
+	}
+}
+
+func TestConfigResolveCredentials(t *testing.T) {
+	filename := testWriteSharedCredentials(t)
+
+	config := &Config{
+		AccessKey:             "static-access-key",
+		SecretKey:             "static-secret-key",
+		Profile:               "dev",
+		SharedCredentialsFile: filename,
+	}
+
+	if accessKey, _, _ := config.resolveCredentials(); accessKey != "static-access-key" {
+		t.Fatalf("Static credentials must take precedence over the shared credentials file, got %s", accessKey)
+	}
+
+	config.AccessKey = ""
+	config.SecretKey = ""
+	if accessKey, _, _ := config.resolveCredentials(); accessKey != "dev-access-key" {
+		t.Fatalf("Expected credentials of profile dev, got %s", accessKey)
+	}
+
+	config.SharedCredentialsFile = filepath.Join(filepath.Dir(filename), "not-exist")
+	if _, _, err := config.resolveCredentials(); err == nil {
+		t.Fatalf("Missing credentials must throw error")
+	}
+}
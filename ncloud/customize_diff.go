@@ -3,6 +3,7 @@ package ncloud
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,3 +18,24 @@ func ncloudVpcCommonCustomizeDiff(_ context.Context, diff *schema.ResourceDiff,
 
 	return nil
 }
+
+// ncloudTagsAllCustomizeDiff sets `tags_all` to the `tag_list` merged with the provider default tags.
+// Instance tags are only supported on classic.
+func ncloudTagsAllCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*ProviderConfig)
+	if !ok || config.SupportVPC {
+		return nil
+	}
+
+	if !diff.NewValueKnown("tag_list") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tagsAll := mergeDefaultTags(config.DefaultTags, diff.Get("tag_list").([]interface{}))
+
+	if reflect.DeepEqual(expandStringMap(diff.Get("tags_all").(map[string]interface{})), tagsAll) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
@@ -59,7 +59,9 @@ func dataSourceNcloudAccessControlGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 		},
 	}
 }
@@ -73,14 +75,18 @@ func dataSourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interfa
 		resources, err = getVpcAccessControlGroupList(d, config)
 	} else {
 		resources, err = getClassicAccessControlGroupList(d, config)
+		if err == nil {
+			resources, err = ApplyFilters(dataSourceFilters(d), resources, dataSourceNcloudAccessControlGroup().Schema)
+		}
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudAccessControlGroup().Schema)
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudAccessControlGroup().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -103,17 +109,31 @@ func getVpcAccessControlGroupList(d *schema.ResourceData, config *ProviderConfig
 		reqParams.AccessControlGroupNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcAccessControlGroup", reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":                      filterParamStringList(&reqParams.AccessControlGroupNoList),
+		"access_control_group_no": filterParamStringList(&reqParams.AccessControlGroupNoList),
+		"vpc_no":                  filterParamString(&reqParams.VpcNo),
+	})
+
+	var accessControlGroupList []*vserver.AccessControlGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcAccessControlGroup", reqParams)
+		resp, err := config.Client.vserver.V2Api.GetAccessControlGroupList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcAccessControlGroup", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcAccessControlGroup", resp)
 
-	resp, err := config.Client.vserver.V2Api.GetAccessControlGroupList(reqParams)
+		accessControlGroupList = append(accessControlGroupList, resp.AccessControlGroupList...)
+		return len(resp.AccessControlGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcAccessControlGroup", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcAccessControlGroup", resp)
 
 	var resources []map[string]interface{}
-	for _, r := range resp.AccessControlGroupList {
+	for _, r := range accessControlGroupList {
 		instance := map[string]interface{}{
 			"id":                      *r.AccessControlGroupNo,
 			"access_control_group_no": *r.AccessControlGroupNo,
@@ -126,7 +146,7 @@ func getVpcAccessControlGroupList(d *schema.ResourceData, config *ProviderConfig
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudAccessControlGroup().Schema)
 }
 
 func getClassicAccessControlGroupList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
@@ -148,19 +168,25 @@ func getClassicAccessControlGroupList(d *schema.ResourceData, config *ProviderCo
 		reqParams.IsDefault = ncloud.Bool(v.(bool))
 	}
 
-	reqParams.PageNo = ncloud.Int32(1)
-
-	logCommonRequest("getClassicAccessControlGroupList", reqParams)
+	var accessControlGroupList []*server.AccessControlGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicAccessControlGroupList", reqParams)
+		resp, err := client.server.V2Api.GetAccessControlGroupList(&reqParams)
+		if err != nil {
+			logErrorResponse("getClassicAccessControlGroupList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicAccessControlGroupList", resp)
 
-	resp, err := client.server.V2Api.GetAccessControlGroupList(&reqParams)
+		accessControlGroupList = append(accessControlGroupList, resp.AccessControlGroupList...)
+		return len(resp.AccessControlGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicAccessControlGroupList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicAccessControlGroupList", resp)
 
 	var resources []map[string]interface{}
-	for _, r := range resp.AccessControlGroupList {
+	for _, r := range accessControlGroupList {
 		instance := map[string]interface{}{
 			"id":                      *r.AccessControlGroupConfigurationNo,
 			"access_control_group_no": *r.AccessControlGroupConfigurationNo,
@@ -38,16 +38,16 @@ func dataSourceNcloudAccessControlGroups() *schema.Resource {
 				Optional:    true,
 				Description: "Name of the ACG you want to get",
 			},
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"access_control_groups": {
 				Type:        schema.TypeList,
 				Optional:    true,
 				Description: "A List of access control group configuration no",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -61,20 +61,24 @@ func dataSourceNcloudAccessControlGroupsRead(d *schema.ResourceData, meta interf
 		resources, err = getVpcAccessControlGroupList(d, config)
 	} else {
 		resources, err = getClassicAccessControlGroupList(d, config)
+		if err == nil {
+			resources, err = ApplyFilters(dataSourceFilters(d), resources, dataSourceNcloudAccessControlGroups().Schema)
+		}
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudAccessControlGroups().Schema)
-	}
-
 	if len(resources) < 1 {
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	resources, err = ApplySort(d, resources, dataSourceNcloudAccessControlGroup().Schema)
+	if err != nil {
+		return err
+	}
+
 	return accessControlGroupsAttributes(d, resources)
 }
 
@@ -88,10 +92,6 @@ func accessControlGroupsAttributes(d *schema.ResourceData, accessControlGroups [
 	d.SetId(dataResourceIdHash(ids))
 	d.Set("access_control_groups", ids)
 
-	// create a json file in current directory and write d source to it.
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("access_control_groups"))
-	}
-
-	return nil
+	// write the data source to `output_file` if set
+	return writeDataSourceOutput(d, "access_control_groups")
 }
@@ -38,10 +38,8 @@ func dataSourceNcloudAccessControlRules() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "A list of access control rules configuration no",
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -101,9 +99,5 @@ func accessControlRulesAttributes(d *schema.ResourceData, accessControlRules []*
 		return err
 	}
 
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("access_control_rules"))
-	}
-
-	return nil
+	return writeDataSourceOutput(d, "access_control_rules")
 }
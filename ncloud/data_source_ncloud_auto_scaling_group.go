@@ -38,7 +38,15 @@ func dataSourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface
 
 	autoScalingGroupListMap := ConvertToArrayMap(autoScalingGroupList)
 	if f, ok := d.GetOk("filter"); ok {
-		autoScalingGroupListMap = ApplyFilters(f.(*schema.Set), autoScalingGroupListMap, dataSourceNcloudAutoScalingGroup().Schema)
+		autoScalingGroupListMap, err = ApplyFilters(f.(*schema.Set), autoScalingGroupListMap, dataSourceNcloudAutoScalingGroup().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	autoScalingGroupListMap, err = ApplySingularSort(d, autoScalingGroupListMap, dataSourceNcloudAutoScalingGroup().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(autoScalingGroupListMap)); err != nil {
@@ -68,17 +76,26 @@ func getVpcAutoScalingGroupList(config *ProviderConfig, id string) ([]*AutoScali
 		reqParams.AutoScalingGroupNoList = []*string{ncloud.String(id)}
 	}
 
-	resp, err := config.Client.vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*vautoscaling.AutoScalingGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.AutoScalingGroupList) < 1 {
+	if len(autoScalingGroupList) < 1 {
 		return nil, nil
 	}
 
 	list := make([]*AutoScalingGroup, 0)
-	for _, a := range resp.AutoScalingGroupList {
+	for _, a := range autoScalingGroupList {
 		list = append(list, &AutoScalingGroup{
 			AutoScalingGroupNo:                   a.AutoScalingGroupNo,
 			AutoScalingGroupName:                 a.AutoScalingGroupName,
@@ -105,16 +122,25 @@ func getVpcAutoScalingGroupList(config *ProviderConfig, id string) ([]*AutoScali
 func getClassicAutoScalingGroupList(config *ProviderConfig, id string) ([]*AutoScalingGroup, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: providerRegionNo(config),
 	}
 
-	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*autoscaling.AutoScalingGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingGroup, 0)
-	for _, a := range resp.AutoScalingGroupList {
+	for _, a := range autoScalingGroupList {
 		autoScalingGroup := &AutoScalingGroup{
 			AutoScalingGroupNo:                   a.AutoScalingGroupNo,
 			AutoScalingGroupName:                 a.AutoScalingGroupName,
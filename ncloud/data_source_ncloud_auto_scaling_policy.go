@@ -42,7 +42,15 @@ func dataSourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interfac
 
 	policyListMap := ConvertToArrayMap(policyList)
 	if f, ok := d.GetOk("filter"); ok {
-		policyListMap = ApplyFilters(f.(*schema.Set), policyListMap, dataSourceNcloudAutoScalingPolicy().Schema)
+		policyListMap, err = ApplyFilters(f.(*schema.Set), policyListMap, dataSourceNcloudAutoScalingPolicy().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	policyListMap, err = ApplySingularSort(d, policyListMap, dataSourceNcloudAutoScalingPolicy().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(policyListMap)); err != nil {
@@ -103,13 +111,22 @@ func getClassicAutoScalingPolicyList(d *schema.ResourceData, config *ProviderCon
 		reqParams.PolicyNameList = []*string{ncloud.String(d.Id())}
 	}
 
-	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
+	var scalingPolicyList []*autoscaling.ScalingPolicy
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		scalingPolicyList = append(scalingPolicyList, resp.ScalingPolicyList...)
+		return len(resp.ScalingPolicyList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingPolicy, 0)
-	for _, p := range resp.ScalingPolicyList {
+	for _, p := range scalingPolicyList {
 		asg, err := getClassicAutoScalingGroupByName(config, *p.AutoScalingGroupName)
 		if err != nil {
 			return nil, err
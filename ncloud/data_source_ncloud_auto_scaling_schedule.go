@@ -42,7 +42,15 @@ func dataSourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interf
 
 	scheduleListMap := ConvertToArrayMap(scheduleList)
 	if f, ok := d.GetOk("filter"); ok {
-		scheduleListMap = ApplyFilters(f.(*schema.Set), scheduleListMap, dataSourceNcloudAutoScalingSchedule().Schema)
+		scheduleListMap, err = ApplyFilters(f.(*schema.Set), scheduleListMap, dataSourceNcloudAutoScalingSchedule().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	scheduleListMap, err = ApplySingularSort(d, scheduleListMap, dataSourceNcloudAutoScalingSchedule().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(scheduleListMap)); err != nil {
@@ -71,13 +79,22 @@ func getVpcAutoScalingScheduleList(d *schema.ResourceData, config *ProviderConfi
 		reqParams.ScheduledActionNameList = []*string{ncloud.String(d.Id())}
 	}
 
-	resp, err := config.Client.vautoscaling.V2Api.GetScheduledActionList(reqParams)
+	var scheduledUpdateGroupActionList []*vautoscaling.ScheduledUpdateGroupAction
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vautoscaling.V2Api.GetScheduledActionList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		scheduledUpdateGroupActionList = append(scheduledUpdateGroupActionList, resp.ScheduledUpdateGroupActionList...)
+		return len(resp.ScheduledUpdateGroupActionList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingSchedule, 0)
-	for _, s := range resp.ScheduledUpdateGroupActionList {
+	for _, s := range scheduledUpdateGroupActionList {
 		schedule := &AutoScalingSchedule{
 			ScheduledActionNo:   s.ScheduledActionNo,
 			ScheduledActionName: s.ScheduledActionName,
@@ -106,13 +123,22 @@ func getClassicAutoScalingScheduleList(d *schema.ResourceData, config *ProviderC
 		reqParams.ScheduledActionNameList = []*string{ncloud.String(d.Id())}
 	}
 
-	resp, err := config.Client.autoscaling.V2Api.GetScheduledActionList(reqParams)
+	var scheduledUpdateGroupActionList []*autoscaling.ScheduledUpdateGroupAction
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetScheduledActionList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		scheduledUpdateGroupActionList = append(scheduledUpdateGroupActionList, resp.ScheduledUpdateGroupActionList...)
+		return len(resp.ScheduledUpdateGroupActionList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	list := make([]*AutoScalingSchedule, 0)
-	for _, s := range resp.ScheduledUpdateGroupActionList {
+	for _, s := range scheduledUpdateGroupActionList {
 		asg, err := getClassicAutoScalingGroupByName(config, *s.AutoScalingGroupName)
 		if err != nil {
 			return nil, err
@@ -44,7 +44,15 @@ func dataSourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{})
 
 	resources := ConvertToArrayMap(instances)
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudBlockStorage().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudBlockStorage().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudBlockStorage().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -66,7 +74,7 @@ func getBlockStorageList(d *schema.ResourceData, config *ProviderConfig) ([]*Blo
 }
 
 func getClassicBlockStorageList(d *schema.ResourceData, config *ProviderConfig) ([]*BlockStorage, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -80,17 +88,25 @@ func getClassicBlockStorageList(d *schema.ResourceData, config *ProviderConfig)
 		reqParams.BlockStorageInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getClassicBlockStorageList", reqParams)
+	var blockStorageInstanceList []*server.BlockStorageInstance
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicBlockStorageList", reqParams)
+		resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicBlockStorageList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicBlockStorageList", resp)
 
-	resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(reqParams)
+		blockStorageInstanceList = append(blockStorageInstanceList, resp.BlockStorageInstanceList...)
+		return len(resp.BlockStorageInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicBlockStorageList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicBlockStorageList", resp)
 
 	var list []*BlockStorage
-	for _, r := range resp.BlockStorageInstanceList {
+	for _, r := range blockStorageInstanceList {
 		instance := &BlockStorage{
 			BlockStorageInstanceNo:  r.BlockStorageInstanceNo,
 			ServerInstanceNo:        r.ServerInstanceNo,
@@ -122,17 +138,25 @@ func getVpcBlockStorageList(d *schema.ResourceData, config *ProviderConfig) ([]*
 		reqParams.BlockStorageInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcBlockStorageList", reqParams)
+	var blockStorageInstanceList []*vserver.BlockStorageInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcBlockStorageList", reqParams)
+		resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcBlockStorageList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcBlockStorageList", resp)
 
-	resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceList(reqParams)
+		blockStorageInstanceList = append(blockStorageInstanceList, resp.BlockStorageInstanceList...)
+		return len(resp.BlockStorageInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcBlockStorage", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcBlockStorageList", resp)
 
 	var list []*BlockStorage
-	for _, r := range resp.BlockStorageInstanceList {
+	for _, r := range blockStorageInstanceList {
 		instance := &BlockStorage{
 			BlockStorageInstanceNo:  r.BlockStorageInstanceNo,
 			ServerInstanceNo:        r.ServerInstanceNo,
@@ -43,7 +43,9 @@ func dataSourceNcloudBlockStorageSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 		},
 	}
 }
@@ -61,7 +63,15 @@ func dataSourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta inter
 
 	resources := ConvertToArrayMap(instances)
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudBlockStorageSnapshot().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudBlockStorageSnapshot().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudBlockStorageSnapshot().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -83,7 +93,7 @@ func getBlockStorageSnapshot(d *schema.ResourceData, config *ProviderConfig) ([]
 }
 
 func getClassicBlockStorageSnapshot(d *schema.ResourceData, config *ProviderConfig) ([]*BlockStorageSnapshot, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -100,16 +110,25 @@ func getClassicBlockStorageSnapshot(d *schema.ResourceData, config *ProviderConf
 		reqParams.BlockStorageSnapshotInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getClassicBlockStorageSnapshot", reqParams)
-	resp, err := config.Client.server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+	var blockStorageSnapshotInstanceList []*server.BlockStorageSnapshotInstance
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicBlockStorageSnapshot", reqParams)
+		resp, err := config.Client.server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicBlockStorageSnapshot", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicBlockStorageSnapshot", resp)
+
+		blockStorageSnapshotInstanceList = append(blockStorageSnapshotInstanceList, resp.BlockStorageSnapshotInstanceList...)
+		return len(resp.BlockStorageSnapshotInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicBlockStorageSnapshot", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicBlockStorageSnapshot", resp)
 
 	var list []*BlockStorageSnapshot
-	for _, r := range resp.BlockStorageSnapshotInstanceList {
+	for _, r := range blockStorageSnapshotInstanceList {
 		list = append(list, convertClassicSnapshotInstance(r))
 	}
 
@@ -143,16 +162,25 @@ func getVpcBlockStorageSnapshot(d *schema.ResourceData, config *ProviderConfig)
 		reqParams.BlockStorageSnapshotInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcBlockStorageSnapshot", reqParams)
-	resp, err := config.Client.vserver.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+	var blockStorageSnapshotInstanceList []*vserver.BlockStorageSnapshotInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcBlockStorageSnapshot", reqParams)
+		resp, err := config.Client.vserver.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcBlockStorageSnapshot", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcBlockStorageSnapshot", resp)
+
+		blockStorageSnapshotInstanceList = append(blockStorageSnapshotInstanceList, resp.BlockStorageSnapshotInstanceList...)
+		return len(resp.BlockStorageSnapshotInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcBlockStorageSnapshot", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcBlockStorageSnapshot", resp)
 
 	var list []*BlockStorageSnapshot
-	for _, r := range resp.BlockStorageSnapshotInstanceList {
+	for _, r := range blockStorageSnapshotInstanceList {
 		list = append(list, convertVpcSnapshotInstance(r))
 	}
 
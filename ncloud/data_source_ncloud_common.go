@@ -2,13 +2,29 @@ package ncloud
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputFormatJson = "json"
+	outputFormatYaml = "yaml"
+	outputFormatCsv  = "csv"
 )
 
+// outputFileMode is the permission of the files written by `output_file`
+const outputFileMode os.FileMode = 0644
+
 // Generates a hash for the set hash function used by the ID
 func dataResourceIdHash(ids []string) string {
 	var buf bytes.Buffer
@@ -20,17 +36,160 @@ func dataResourceIdHash(ids []string) string {
 	return fmt.Sprintf("%d", hashcode(buf.String()))
 }
 
-func writeToFile(filePath string, data interface{}) error {
-	log.Printf("[INFO] WriteToFile FilePath: %s", filePath)
+func dataSourceOutputFileSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of file that can save data source after running `terraform plan`.",
+	}
+}
 
-	if err := os.Remove(filePath); err != nil && os.IsNotExist(err) != true {
-		return err
+func dataSourceOutputFormatSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          outputFormatJson,
+		ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{outputFormatJson, outputFormatYaml, outputFormatCsv}, false)),
+		Description:      "The format of `output_file`. `json`, `yaml` or `csv`.",
+	}
+}
+
+// writeDataSourceOutput writes the attribute of the plural data source to `output_file` in `output_format`, if `output_file` is set
+func writeDataSourceOutput(d *schema.ResourceData, attribute string) error {
+	output, ok := d.GetOk("output_file")
+	if !ok || output.(string) == "" {
+		return nil
 	}
 
-	bs, err := json.MarshalIndent(data, "", "\t")
+	format, _ := d.Get("output_format").(string)
+	if format == "" {
+		format = outputFormatJson
+	}
+
+	return writeToFile(output.(string), format, attribute, d.Get(attribute))
+}
+
+func writeToFile(filePath string, format string, attribute string, data interface{}) error {
+	log.Printf("[INFO] WriteToFile FilePath: %s, Format: %s", filePath, format)
+
+	var bs []byte
+	var err error
+
+	switch format {
+	case outputFormatJson:
+		bs, err = json.MarshalIndent(data, "", "\t")
+	case outputFormatYaml:
+		bs, err = yaml.Marshal(data)
+	case outputFormatCsv:
+		bs, err = marshalCsv(attribute, data)
+	default:
+		err = fmt.Errorf("unsupported output format: %s", format)
+	}
 	if err != nil {
 		return err
 	}
-	str := string(bs)
-	return ioutil.WriteFile(filePath, []byte(str), 777)
+
+	// Remove the previous file so that the permission of a new file is applied
+	if err := os.Remove(filePath); err != nil && os.IsNotExist(err) != true {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, bs, outputFileMode)
+}
+
+// marshalCsv writes a row for each item of the list. The columns are the fields of the items,
+// or the attribute itself for a list of scalar values such as ids.
+func marshalCsv(attribute string, data interface{}) ([]byte, error) {
+	items, ok := data.([]interface{})
+	if !ok {
+		items = []interface{}{data}
+	}
+
+	var header []string
+	if isMapList(items) {
+		keys := map[string]bool{}
+		for _, item := range items {
+			for k := range item.(map[string]interface{}) {
+				keys[k] = true
+			}
+		}
+		for k := range keys {
+			header = append(header, k)
+		}
+		sort.Strings(header)
+	} else {
+		header = []string{attribute}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		var record []string
+		if m, ok := item.(map[string]interface{}); ok {
+			for _, k := range header {
+				v, err := csvValue(m[k])
+				if err != nil {
+					return nil, err
+				}
+				record = append(record, v)
+			}
+		} else {
+			v, err := csvValue(item)
+			if err != nil {
+				return nil, err
+			}
+			record = []string{v}
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+func isMapList(items []interface{}) bool {
+	for _, item := range items {
+		if _, ok := item.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+
+	return len(items) > 0
+}
+
+// csvValue formats the value of a cell. A list of scalar values is joined by ",", and others are written in JSON
+func csvValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case *string:
+		if v == nil {
+			return "", nil
+		}
+		return *v, nil
+	case []interface{}:
+		var values []string
+		for _, e := range v {
+			switch e.(type) {
+			case map[string]interface{}, []interface{}:
+				bs, err := json.Marshal(v)
+				return string(bs), err
+			}
+			s, _ := csvValue(e)
+			values = append(values, s)
+		}
+		return strings.Join(values, ","), nil
+	case map[string]interface{}:
+		bs, err := json.Marshal(v)
+		return string(bs), err
+	}
+
+	return fmt.Sprint(v), nil
 }
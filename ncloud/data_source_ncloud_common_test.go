@@ -2,9 +2,14 @@ package ncloud
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"testing"
 )
 
 const skipNoResultsTest = true
@@ -34,3 +39,74 @@ func TestAccValidateOneResult(t *testing.T) {
 		t.Fatalf("2 results must throw 'more than one found results'")
 	}
 }
+
+func TestWriteToFile(t *testing.T) {
+	data := []interface{}{
+		map[string]interface{}{"vpc_no": "1", "name": "web", "access_control_groups": []interface{}{"10", "11"}},
+		map[string]interface{}{"vpc_no": "2", "name": "db, prod", "access_control_groups": []interface{}{}},
+	}
+
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{outputFormatJson, "[\n\t{\n\t\t\"access_control_groups\": [\n\t\t\t\"10\",\n\t\t\t\"11\"\n\t\t],\n\t\t\"name\": \"web\",\n\t\t\"vpc_no\": \"1\"\n\t},\n\t{\n\t\t\"access_control_groups\": [],\n\t\t\"name\": \"db, prod\",\n\t\t\"vpc_no\": \"2\"\n\t}\n]"},
+		{outputFormatYaml, "- access_control_groups:\n    - \"10\"\n    - \"11\"\n  name: web\n  vpc_no: \"1\"\n- access_control_groups: []\n  name: db, prod\n  vpc_no: \"2\"\n"},
+		{outputFormatCsv, "access_control_groups,name,vpc_no\n\"10,11\",web,1\n,\"db, prod\",2\n"},
+	}
+
+	for _, tc := range cases {
+		filePath := filepath.Join(t.TempDir(), "output."+tc.format)
+		if err := writeToFile(filePath, tc.format, "vpcs", data); err != nil {
+			t.Fatalf("%s: %s", tc.format, err)
+		}
+
+		bs, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(bs) != tc.expected {
+			t.Errorf("%s: Expected: %q, Actual: %q", tc.format, tc.expected, string(bs))
+		}
+
+		info, err := os.Stat(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The umask may only narrow the permission
+		if info.Mode().Perm()&^outputFileMode != 0 {
+			t.Errorf("%s: Expected mode within: %s, Actual: %s", tc.format, outputFileMode, info.Mode().Perm())
+		}
+	}
+}
+
+func TestWriteDataSourceOutput(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"ids":           {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"output_file":   dataSourceOutputFileSchema(),
+		"output_format": dataSourceOutputFormatSchema(),
+	}
+	filePath := filepath.Join(t.TempDir(), "ids.csv")
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"ids":           []interface{}{"1", "2"},
+		"output_file":   filePath,
+		"output_format": outputFormatCsv,
+	})
+	if err := writeDataSourceOutput(d, "ids"); err != nil {
+		t.Fatal(err)
+	}
+
+	bs, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "ids\n1\n2\n"; string(bs) != expected {
+		t.Fatalf("Expected: %q, Actual: %q", expected, string(bs))
+	}
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{"ids": []interface{}{"1"}})
+	if err := writeDataSourceOutput(d, "ids"); err != nil {
+		t.Fatalf("no output_file must not write a file: %s", err)
+	}
+}
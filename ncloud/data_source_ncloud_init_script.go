@@ -35,7 +35,9 @@ func dataSourceNcloudInitScript() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"LNX", "WND"}, false)),
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,6 +61,11 @@ func dataSourceNcloudInitScriptRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudInitScript().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -79,18 +86,26 @@ func getVpcInitScriptListFiltered(d *schema.ResourceData, config *ProviderConfig
 		reqParams.InitScriptNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcInitScriptList", reqParams)
-	resp, err := config.Client.vserver.V2Api.GetInitScriptList(reqParams)
+	var initScriptList []*vserver.InitScript
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcInitScriptList", reqParams)
+		resp, err := config.Client.vserver.V2Api.GetInitScriptList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcInitScriptList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcInitScriptList", resp)
 
+		initScriptList = append(initScriptList, resp.InitScriptList...)
+		return len(resp.InitScriptList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcInitScriptList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcInitScriptList", resp)
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.InitScriptList {
+	for _, r := range initScriptList {
 		instance := map[string]interface{}{
 			"id":             *r.InitScriptNo,
 			"init_script_no": *r.InitScriptNo,
@@ -103,7 +118,10 @@ func getVpcInitScriptListFiltered(d *schema.ResourceData, config *ProviderConfig
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudInitScript().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, resourceNcloudInitScript().Schema)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return resources, nil
@@ -41,7 +41,15 @@ func dataSourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interf
 
 	launchConfigListMap := ConvertToArrayMap(launchConfigList)
 	if f, ok := d.GetOk("filter"); ok {
-		launchConfigListMap = ApplyFilters(f.(*schema.Set), launchConfigListMap, dataSourceNcloudLaunchConfiguration().Schema)
+		launchConfigListMap, err = ApplyFilters(f.(*schema.Set), launchConfigListMap, dataSourceNcloudLaunchConfiguration().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	launchConfigListMap, err = ApplySingularSort(d, launchConfigListMap, dataSourceNcloudLaunchConfiguration().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(launchConfigListMap)); err != nil {
@@ -70,20 +78,29 @@ func getVpcLaunchConfigurationList(config *ProviderConfig, id string) ([]*Launch
 		reqParams.LaunchConfigurationNoList = []*string{ncloud.String(id)}
 	}
 
-	logCommonRequest("getVpcLaunchConfigurationList", reqParams)
-	resp, err := config.Client.vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+	var launchConfigurationList []*vautoscaling.LaunchConfiguration
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcLaunchConfigurationList", reqParams)
+		resp, err := config.Client.vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcLaunchConfigurationList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcLaunchConfigurationList", resp)
+
+		launchConfigurationList = append(launchConfigurationList, resp.LaunchConfigurationList...)
+		return len(resp.LaunchConfigurationList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcLaunchConfigurationList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcLaunchConfigurationList", resp)
 
-	if len(resp.LaunchConfigurationList) < 1 {
+	if len(launchConfigurationList) < 1 {
 		return nil, nil
 	}
 
 	list := make([]*LaunchConfiguration, 0)
-	for _, l := range resp.LaunchConfigurationList {
+	for _, l := range launchConfigurationList {
 		list = append(list, &LaunchConfiguration{
 			LaunchConfigurationName:     l.LaunchConfigurationName,
 			ServerImageProductCode:      l.ServerImageProductCode,
@@ -102,18 +119,27 @@ func getVpcLaunchConfigurationList(config *ProviderConfig, id string) ([]*Launch
 func getClassicLaunchConfigurationList(config *ProviderConfig, id string) ([]*LaunchConfiguration, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
-		RegionNo: &config.RegionNo,
-	}
-	logCommonRequest("getClassicLaunchConfigurationList", reqParams)
-	resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		RegionNo: providerRegionNo(config),
+	}
+	var launchConfigurationList []*autoscaling.LaunchConfiguration
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicLaunchConfigurationList", reqParams)
+		resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicLaunchConfigurationList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicLaunchConfigurationList", resp)
+
+		launchConfigurationList = append(launchConfigurationList, resp.LaunchConfigurationList...)
+		return len(resp.LaunchConfigurationList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicLaunchConfigurationList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicLaunchConfigurationList", resp)
 
 	list := make([]*LaunchConfiguration, 0)
-	for _, l := range resp.LaunchConfigurationList {
+	for _, l := range launchConfigurationList {
 		launchConfiguration := &LaunchConfiguration{
 			LaunchConfigurationNo:       l.LaunchConfigurationNo,
 			LaunchConfigurationName:     l.LaunchConfigurationName,
@@ -31,7 +31,7 @@ func dataSourceNcloudLb() *schema.Resource {
 func dataSourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("datasource `ncloud_lb`"))
+		return diagFromErr(NotSupportClassic("datasource `ncloud_lb`"))
 	}
 
 	if v, ok := d.GetOk("id"); ok {
@@ -40,16 +40,24 @@ func dataSourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	lbList, err := getVpcLoadBalancerList(config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lbListMap := ConvertToArrayMap(lbList)
 	if f, ok := d.GetOk("filter"); ok {
-		lbListMap = ApplyFilters(f.(*schema.Set), lbListMap, dataSourceNcloudLb().Schema)
+		lbListMap, err = ApplyFilters(f.(*schema.Set), lbListMap, dataSourceNcloudLb().Schema)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	lbListMap, err = ApplySingularSort(d, lbListMap, dataSourceNcloudLb().Schema)
+	if err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := validateOneResult(len(lbListMap)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(lbListMap[0]["load_balancer_no"].(string))
@@ -66,13 +74,22 @@ func getVpcLoadBalancerList(config *ProviderConfig, id string) ([]*LoadBalancerI
 		reqParams.LoadBalancerInstanceNoList = []*string{ncloud.String(id)}
 	}
 
-	resp, err := config.Client.vloadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+	var loadBalancerInstanceList []*vloadbalancer.LoadBalancerInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vloadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		loadBalancerInstanceList = append(loadBalancerInstanceList, resp.LoadBalancerInstanceList...)
+		return len(resp.LoadBalancerInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	lbList := make([]*LoadBalancerInstance, 0)
-	for _, lb := range resp.LoadBalancerInstanceList {
+	for _, lb := range loadBalancerInstanceList {
 		lbList = append(lbList, convertVpcLoadBalancer(lb))
 	}
 
@@ -39,7 +39,7 @@ func dataSourceNcloudLbListener() *schema.Resource {
 func dataSourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("datasource `ncloud_lb_listener`"))
+		return diagFromErr(NotSupportClassic("datasource `ncloud_lb_listener`"))
 	}
 
 	if v, ok := d.GetOk("id"); ok {
@@ -49,16 +49,24 @@ func dataSourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData,
 	listenerList, err := getVpcLoadBalancerListenerList(config, d.Id(), d.Get("load_balancer_no").(string))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	listenerListMap := ConvertToArrayMap(listenerList)
 	if f, ok := d.GetOk("filter"); ok {
-		listenerListMap = ApplyFilters(f.(*schema.Set), listenerListMap, dataSourceNcloudLbListener().Schema)
+		listenerListMap, err = ApplyFilters(f.(*schema.Set), listenerListMap, dataSourceNcloudLbListener().Schema)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	listenerListMap, err = ApplySingularSort(d, listenerListMap, dataSourceNcloudLbListener().Schema)
+	if err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := validateOneResult(len(listenerListMap)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(listenerListMap[0]["listener_no"].(string))
@@ -67,7 +67,7 @@ func dataSourceNcloudLbTargetGroup() *schema.Resource {
 func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("datasource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("datasource `ncloud_lb_target_group`"))
 	}
 
 	if v, ok := d.GetOk("id"); ok {
@@ -76,16 +76,24 @@ func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceDa
 
 	targetGroupList, err := getVpcLoadBalancerTargetGroupList(config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	targetGroupListMap := ConvertToArrayMap(targetGroupList)
 	if f, ok := d.GetOk("filter"); ok {
-		targetGroupListMap = ApplyFilters(f.(*schema.Set), targetGroupListMap, dataSourceNcloudLbTargetGroup().Schema)
+		targetGroupListMap, err = ApplyFilters(f.(*schema.Set), targetGroupListMap, dataSourceNcloudLbTargetGroup().Schema)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	targetGroupListMap, err = ApplySingularSort(d, targetGroupListMap, dataSourceNcloudLbTargetGroup().Schema)
+	if err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := validateOneResult(len(targetGroupListMap)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(targetGroupListMap[0]["target_group_no"].(string))
@@ -102,13 +110,22 @@ func getVpcLoadBalancerTargetGroupList(config *ProviderConfig, id string) ([]*Ta
 		reqParams.TargetGroupNoList = []*string{ncloud.String(id)}
 	}
 
-	resp, err := config.Client.vloadbalancer.V2Api.GetTargetGroupList(reqParams)
+	var list []*vloadbalancer.TargetGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vloadbalancer.V2Api.GetTargetGroupList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		list = append(list, resp.TargetGroupList...)
+		return len(resp.TargetGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	targetGroupList := make([]*TargetGroup, 0)
-	for _, tg := range resp.TargetGroupList {
+	for _, tg := range list {
 		targetGroupList = append(targetGroupList, convertVpcTargetGroup(tg))
 	}
 
@@ -28,7 +28,9 @@ func dataSourceNcloudMemberServerImage() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of platform codes of server images to view",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"name_regex": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -108,6 +110,11 @@ func dataSourceNcloudMemberServerImage() *schema.Resource {
 				Computed:    true,
 				Description: "Member server image block storage total size",
 			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image create date",
+			},
 		},
 	}
 }
@@ -128,7 +135,15 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudMemberServerImage().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudMemberServerImage().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudMemberServerImage().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -142,10 +157,10 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 
 func getClassicMemberServerImage(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo := providerRegionNo(config)
 
 	reqParams := &server.GetMemberServerImageListRequest{
-		RegionNo: &regionNo,
+		RegionNo: regionNo,
 	}
 
 	if noList, ok := d.GetOk("no_list"); ok {
@@ -156,18 +171,26 @@ func getClassicMemberServerImage(d *schema.ResourceData, config *ProviderConfig)
 		reqParams.PlatformTypeCodeList = expandStringInterfaceList(platformTypeCodeList.([]interface{}))
 	}
 
-	logCommonRequest("getClassicMemberServerImage", reqParams)
+	var memberServerImageList []*server.MemberServerImage
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicMemberServerImage", reqParams)
+		resp, err := client.server.V2Api.GetMemberServerImageList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicMemberServerImage", err, reqParams)
+			return 0, nil, err
+		}
+		logCommonResponse("getClassicMemberServerImage", GetCommonResponse(resp))
 
-	resp, err := client.server.V2Api.GetMemberServerImageList(reqParams)
+		memberServerImageList = append(memberServerImageList, resp.MemberServerImageList...)
+		return len(resp.MemberServerImageList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicMemberServerImage", err, reqParams)
 		return nil, err
 	}
-	logCommonResponse("getClassicMemberServerImage", GetCommonResponse(resp))
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.MemberServerImageList {
+	for _, r := range memberServerImageList {
 		instance := map[string]interface{}{
 			"id":                                    *r.MemberServerImageNo,
 			"no":                                    *r.MemberServerImageNo,
@@ -181,6 +204,7 @@ func getClassicMemberServerImage(d *schema.ResourceData, config *ProviderConfig)
 			"original_os_information":               *r.OriginalOsInformation,
 			"original_server_image_name":            *r.OriginalServerImageName,
 			"platform_type":                         *r.MemberServerImagePlatformType.Code,
+			"create_date":                           StringOrEmpty(r.CreateDate),
 		}
 
 		if r.MemberServerImageBlockStorageTotalRows != nil {
@@ -213,18 +237,26 @@ func getVpcMemberServerImage(d *schema.ResourceData, config *ProviderConfig) ([]
 		reqParams.PlatformTypeCodeList = expandStringInterfaceList(platformTypeCodeList.([]interface{}))
 	}
 
-	logCommonRequest("getVpcMemberServerImage", reqParams)
+	var memberServerImageInstanceList []*vserver.MemberServerImageInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcMemberServerImage", reqParams)
+		resp, err := client.vserver.V2Api.GetMemberServerImageInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcMemberServerImage", err, reqParams)
+			return 0, nil, err
+		}
+		logCommonResponse("getVpcMemberServerImage", GetCommonResponse(resp))
 
-	resp, err := client.vserver.V2Api.GetMemberServerImageInstanceList(reqParams)
+		memberServerImageInstanceList = append(memberServerImageInstanceList, resp.MemberServerImageInstanceList...)
+		return len(resp.MemberServerImageInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcMemberServerImage", err, reqParams)
 		return nil, err
 	}
-	logCommonResponse("getVpcMemberServerImage", GetCommonResponse(resp))
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.MemberServerImageInstanceList {
+	for _, r := range memberServerImageInstanceList {
 		instance := map[string]interface{}{
 			"id":                                 *r.MemberServerImageInstanceNo,
 			"no":                                 *r.MemberServerImageInstanceNo,
@@ -232,6 +264,7 @@ func getVpcMemberServerImage(d *schema.ResourceData, config *ProviderConfig) ([]
 			"description":                        *r.MemberServerImageDescription,
 			"original_server_instance_no":        *r.OriginalServerInstanceNo,
 			"original_server_image_product_code": *r.OriginalServerImageProductCode,
+			"create_date":                        StringOrEmpty(r.CreateDate),
 		}
 
 		if r.MemberServerImageBlockStorageTotalRows != nil {
@@ -28,7 +28,9 @@ func dataSourceNcloudMemberServerImages() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of platform codes of server images to view",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"name_regex": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -49,11 +51,8 @@ func dataSourceNcloudMemberServerImages() *schema.Resource {
 				Description: "A list of Member server image no",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			"output_file": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The name of file that can save data source after running `terraform plan`.",
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -74,13 +73,21 @@ func dataSourceNcloudMemberServerImagesRead(d *schema.ResourceData, meta interfa
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudMemberServerImage().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudMemberServerImage().Schema)
+		if err != nil {
+			return err
+		}
 	}
 
 	if len(resources) < 1 {
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	resources, err = ApplySort(d, resources, dataSourceNcloudMemberServerImage().Schema)
+	if err != nil {
+		return err
+	}
+
 	return memberServerImagesAttributes(d, resources)
 }
 
@@ -94,9 +101,5 @@ func memberServerImagesAttributes(d *schema.ResourceData, memberServerImages []m
 	d.SetId(dataResourceIdHash(ids))
 	d.Set("member_server_images", ids)
 
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("member_server_images"))
-	}
-
-	return nil
+	return writeDataSourceOutput(d, "member_server_images")
 }
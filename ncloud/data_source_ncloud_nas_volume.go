@@ -63,7 +63,15 @@ func dataSourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) err
 
 	resources := ConvertToArrayMap(instances)
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNasVolume().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNasVolume().Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudNasVolume().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -87,7 +95,7 @@ func getNasVolumeList(d *schema.ResourceData, config *ProviderConfig) ([]*NasVol
 func getClassicNasVolumeList(d *schema.ResourceData, config *ProviderConfig) ([]*NasVolume, error) {
 	client := config.Client
 
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -141,17 +149,25 @@ func getVpcNasVolumeList(d *schema.ResourceData, config *ProviderConfig) ([]*Nas
 		reqParams.NasVolumeInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcNasVolumeList", reqParams)
+	var nasVolumeInstanceList []*vnas.NasVolumeInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcNasVolumeList", reqParams)
+		resp, err := client.vnas.V2Api.GetNasVolumeInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcNasVolumeList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcNasVolumeList", resp)
 
-	resp, err := client.vnas.V2Api.GetNasVolumeInstanceList(reqParams)
+		nasVolumeInstanceList = append(nasVolumeInstanceList, resp.NasVolumeInstanceList...)
+		return len(resp.NasVolumeInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcNasVolumeList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcNasVolumeList", resp)
 
 	var list []*NasVolume
-	for _, r := range resp.NasVolumeInstanceList {
+	for _, r := range nasVolumeInstanceList {
 		list = append(list, convertVpcNasVolume(r))
 	}
 
@@ -45,7 +45,9 @@ func dataSourceNcloudNasVolumes() *schema.Resource {
 				Optional:    true,
 				Description: "Zone code. Get available values using the `data ncloud_zones`.",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -56,10 +58,8 @@ func dataSourceNcloudNasVolumes() *schema.Resource {
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudNasVolume()),
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -75,13 +75,21 @@ func dataSourceNcloudNasVolumesRead(d *schema.ResourceData, meta interface{}) er
 	resources := ConvertToArrayMap(instances)
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNasVolumes().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNasVolumes().Schema)
+		if err != nil {
+			return err
+		}
 	}
 
 	if len(resources) < 1 {
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	resources, err = ApplySort(d, resources, dataSourceNcloudNasVolume().Schema)
+	if err != nil {
+		return err
+	}
+
 	var ids []string
 	for _, r := range resources {
 		ids = append(ids, r["nas_volume_no"].(string))
@@ -96,9 +104,5 @@ func dataSourceNcloudNasVolumesRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("nas_volumes"))
-	}
-
-	return nil
+	return writeDataSourceOutput(d, "nas_volumes")
 }
@@ -45,6 +45,11 @@ func dataSourceNcloudNatGatewayRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudNatGateway().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -71,19 +76,33 @@ func getNatGatewayListFiltered(d *schema.ResourceData, config *ProviderConfig) (
 		reqParams.VpcName = ncloud.String(v.(string))
 	}
 
-	logCommonRequest("GetNatGatewayInstanceList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetNatGatewayInstanceList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":             filterParamStringList(&reqParams.NatGatewayInstanceNoList),
+		"nat_gateway_no": filterParamStringList(&reqParams.NatGatewayInstanceNoList),
+		"public_ip":      filterParamString(&reqParams.PublicIp),
+		"zone":           filterParamString(&reqParams.ZoneCode),
+	})
+
+	var natGatewayInstanceList []*vpc.NatGatewayInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetNatGatewayInstanceList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetNatGatewayInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetNatGatewayInstanceList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetNatGatewayInstanceList", resp)
 
+		natGatewayInstanceList = append(natGatewayInstanceList, resp.NatGatewayInstanceList...)
+		return len(resp.NatGatewayInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetNatGatewayInstanceList", err, reqParams)
 		return nil, err
 	}
 
-	logResponse("GetNatGatewayInstanceList", resp)
-
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.NatGatewayInstanceList {
+	for _, r := range natGatewayInstanceList {
 		instance := map[string]interface{}{
 			"id":             *r.NatGatewayInstanceNo,
 			"nat_gateway_no": *r.NatGatewayInstanceNo,
@@ -97,8 +116,9 @@ func getNatGatewayListFiltered(d *schema.ResourceData, config *ProviderConfig) (
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudNatGateway().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudNatGateway().Schema)
+	if err != nil {
+		return nil, err
 	}
 
 	return resources, nil
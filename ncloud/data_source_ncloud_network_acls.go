@@ -34,13 +34,17 @@ func dataSourceNcloudNetworkAcls() *schema.Resource {
 				Optional:    true,
 				Description: "The VPC ID that you want to filter from.",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 
 			"network_acls": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudNetworkACL()),
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -68,22 +72,36 @@ func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) e
 		reqParams.VpcNo = ncloud.String(v.(string))
 	}
 
-	logCommonRequest("GetNetworkAclList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetNetworkAclList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":             filterParamStringList(&reqParams.NetworkAclNoList),
+		"network_acl_no": filterParamStringList(&reqParams.NetworkAclNoList),
+		"vpc_no":         filterParamString(&reqParams.VpcNo),
+	})
+
+	var networkAclList []*vpc.NetworkAcl
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetNetworkAclList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetNetworkAclList(reqParams)
+		if err != nil {
+			logErrorResponse("GetNetworkAclList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetNetworkAclList", resp)
 
+		networkAclList = append(networkAclList, resp.NetworkAclList...)
+		return len(resp.NetworkAclList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetNetworkAclList", err, reqParams)
 		return err
 	}
-	logResponse("GetNetworkAclList", resp)
 
-	if resp == nil || len(resp.NetworkAclList) == 0 {
+	if len(networkAclList) == 0 {
 		return fmt.Errorf("no matching Network ACL found")
 	}
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkAclList {
+	for _, r := range networkAclList {
 		instance := map[string]interface{}{
 			"id":             *r.NetworkAclNo,
 			"network_acl_no": *r.NetworkAclNo,
@@ -96,8 +114,14 @@ func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) e
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudNetworkACL().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudNetworkACL().Schema)
+	if err != nil {
+		return err
+	}
+
+	resources, err = ApplySort(d, resources, resourceNcloudNetworkACL().Schema)
+	if err != nil {
+		return err
 	}
 
 	d.SetId(time.Now().UTC().String())
@@ -105,5 +129,5 @@ func dataSourceNcloudNetworkAclsRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting Network ACLs: %s", err)
 	}
 
-	return nil
+	return writeDataSourceOutput(d, "network_acls")
 }
@@ -31,12 +31,16 @@ func dataSourceNcloudNetworkACLDenyAllowGroups() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"network_acl_deny_allow_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudNetworkACLDenyAllowGroup()),
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -64,22 +68,36 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 		reqParams.VpcNo = ncloud.String(v.(string))
 	}
 
-	logCommonRequest("GetNetworkAclDenyAllowGroupList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetNetworkAclDenyAllowGroupList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":                              filterParamStringList(&reqParams.NetworkAclDenyAllowGroupNoList),
+		"network_acl_deny_allow_group_no": filterParamStringList(&reqParams.NetworkAclDenyAllowGroupNoList),
+		"vpc_no":                          filterParamString(&reqParams.VpcNo),
+	})
+
+	var networkAclDenyAllowGroupList []*vpc.NetworkAclDenyAllowGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetNetworkAclDenyAllowGroupList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetNetworkAclDenyAllowGroupList(reqParams)
+		if err != nil {
+			logErrorResponse("GetNetworkAclDenyAllowGroupList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetNetworkAclDenyAllowGroupList", resp)
 
+		networkAclDenyAllowGroupList = append(networkAclDenyAllowGroupList, resp.NetworkAclDenyAllowGroupList...)
+		return len(resp.NetworkAclDenyAllowGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetNetworkAclDenyAllowGroupList", err, reqParams)
 		return err
 	}
-	logResponse("GetNetworkAclDenyAllowGroupList", resp)
 
-	if resp == nil || len(resp.NetworkAclDenyAllowGroupList) == 0 {
+	if len(networkAclDenyAllowGroupList) == 0 {
 		return fmt.Errorf("no matching NetworkAclDenyAllowGroup found")
 	}
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkAclDenyAllowGroupList {
+	for _, r := range networkAclDenyAllowGroupList {
 		m := map[string]interface{}{
 			"id":                              *r.NetworkAclDenyAllowGroupNo,
 			"network_acl_deny_allow_group_no": *r.NetworkAclDenyAllowGroupNo,
@@ -98,8 +116,14 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 		resources = append(resources, m)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudNetworkACLDenyAllowGroup().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudNetworkACLDenyAllowGroup().Schema)
+	if err != nil {
+		return err
+	}
+
+	resources, err = ApplySort(d, resources, resourceNcloudNetworkACLDenyAllowGroup().Schema)
+	if err != nil {
+		return err
 	}
 
 	d.SetId(time.Now().UTC().String())
@@ -107,5 +131,5 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 		return fmt.Errorf("error setting NetworkAclDenyAllowGroups: %s", err)
 	}
 
-	return nil
+	return writeDataSourceOutput(d, "network_acl_deny_allow_groups")
 }
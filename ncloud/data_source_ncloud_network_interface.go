@@ -48,6 +48,11 @@ func dataSourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudNetworkInterface().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -68,18 +73,32 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *Provider
 		reqParams.NetworkInterfaceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcNetworkInterfaceList", reqParams)
-	resp, err := config.Client.vserver.V2Api.GetNetworkInterfaceList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":                   filterParamStringList(&reqParams.NetworkInterfaceNoList),
+		"network_interface_no": filterParamStringList(&reqParams.NetworkInterfaceNoList),
+		"private_ip":           filterParamString(&reqParams.Ip),
+	})
+
+	var networkInterfaceList []*vserver.NetworkInterface
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcNetworkInterfaceList", reqParams)
+		resp, err := config.Client.vserver.V2Api.GetNetworkInterfaceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcNetworkInterfaceList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcNetworkInterfaceList", resp)
 
+		networkInterfaceList = append(networkInterfaceList, resp.NetworkInterfaceList...)
+		return len(resp.NetworkInterfaceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcNetworkInterfaceList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcNetworkInterfaceList", resp)
 
 	var resources []map[string]interface{}
 
-	for _, r := range resp.NetworkInterfaceList {
+	for _, r := range networkInterfaceList {
 		instance := map[string]interface{}{
 			"id":                   *r.NetworkInterfaceNo,
 			"network_interface_no": *r.NetworkInterfaceNo,
@@ -103,8 +122,9 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *Provider
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudNetworkInterface().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudNetworkInterface().Schema)
+	if err != nil {
+		return nil, err
 	}
 
 	return resources, nil
@@ -25,13 +25,17 @@ func dataSourceNcloudNetworkInterfaces() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 
 			"network_interfaces": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudNetworkInterface()),
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -55,10 +59,15 @@ func dataSourceNcloudNetworkInterfacesRead(d *schema.ResourceData, meta interfac
 		return errors.New("no matching Network Interfaces found")
 	}
 
+	resources, err = ApplySort(d, resources, resourceNcloudNetworkInterface().Schema)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("network_interfaces", resources); err != nil {
 		return fmt.Errorf("error setting Network Interfaces: %s", err)
 	}
 
-	return nil
+	return writeDataSourceOutput(d, "network_interfaces")
 }
@@ -87,13 +87,13 @@ func dataSourceNcloudNKSCluster() *schema.Resource {
 func dataSourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("dataSource `ncloud_nks_cluster`"))
+		return diagFromErr(NotSupportClassic("dataSource `ncloud_nks_cluster`"))
 	}
 
 	uuid := d.Get("uuid").(string)
 	cluster, err := getNKSCluster(ctx, config, uuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if cluster == nil {
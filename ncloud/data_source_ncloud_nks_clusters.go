@@ -19,6 +19,8 @@ func dataSourceNcloudNKSClusters() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -26,12 +28,12 @@ func dataSourceNcloudNKSClusters() *schema.Resource {
 func dataSourceNcloudNKSClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("dataSource `ncloud_nks_clusters`"))
+		return diagFromErr(NotSupportClassic("dataSource `ncloud_nks_clusters`"))
 	}
 
 	clusters, err := getNKSClusters(ctx, config)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var cUuids []*string
@@ -42,5 +44,5 @@ func dataSourceNcloudNKSClustersRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(config.RegionCode)
 	d.Set("cluster_uuids", cUuids)
 
-	return nil
+	return diagFromErr(writeDataSourceOutput(d, "cluster_uuids"))
 }
@@ -30,8 +30,9 @@ func dataSourceNcloudNKSKubeConfig() *schema.Resource {
 				Computed: true,
 			},
 			"client_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"cluster_ca_certificate": {
 				Type:     schema.TypeString,
@@ -44,13 +45,13 @@ func dataSourceNcloudNKSKubeConfig() *schema.Resource {
 func dataSourceNcloudNKSKubeConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("dataSource `ncloud_nks_kube_config`"))
+		return diagFromErr(NotSupportClassic("dataSource `ncloud_nks_kube_config`"))
 	}
 	clusterUuid := d.Get("cluster_uuid").(string)
 
 	kubeConfig, err := getNKSKubeConfig(ctx, config, clusterUuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if kubeConfig == nil {
@@ -72,7 +72,7 @@ func dataSourceNcloudNKSNodePool() *schema.Resource {
 func dataSourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("dataSource `ncloud_nks_node_pool`"))
+		return diagFromErr(NotSupportClassic("dataSource `ncloud_nks_node_pool`"))
 	}
 
 	clusterUuid := d.Get("cluster_uuid").(string)
@@ -81,7 +81,7 @@ func dataSourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData
 
 	nodePool, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if nodePool == nil {
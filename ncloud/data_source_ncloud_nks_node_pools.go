@@ -23,6 +23,8 @@ func dataSourceNcloudNKSNodePools() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -30,14 +32,14 @@ func dataSourceNcloudNKSNodePools() *schema.Resource {
 func dataSourceNcloudNKSNodePoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("dataSource `ncloud_nks_node_pools`"))
+		return diagFromErr(NotSupportClassic("dataSource `ncloud_nks_node_pools`"))
 	}
 
 	clusterUuid := d.Get("cluster_uuid").(string)
 
 	nodePools, err := getNKSNodePools(ctx, config, clusterUuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var npNames []*string
@@ -50,5 +52,5 @@ func dataSourceNcloudNKSNodePoolsRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("cluster_uuid", clusterUuid)
 	d.Set("node_pool_names", npNames)
 
-	return nil
+	return diagFromErr(writeDataSourceOutput(d, "node_pool_names"))
 }
@@ -18,7 +18,9 @@ func dataSourceNcloudNKSVersions() *schema.Resource {
 		Read: dataSourceNcloudVersionsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"versions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -35,6 +37,8 @@ func dataSourceNcloudNKSVersions() *schema.Resource {
 					},
 				},
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -51,7 +55,15 @@ func dataSourceNcloudVersionsRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNKSVersions().Schema["versions"].Elem.(*schema.Resource).Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNKSVersions().Schema["versions"].Elem.(*schema.Resource).Schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	resources, err = ApplySort(d, resources, dataSourceNcloudNKSVersions().Schema["versions"].Elem.(*schema.Resource).Schema)
+	if err != nil {
+		return err
 	}
 
 	d.SetId(time.Now().UTC().String())
@@ -59,8 +71,7 @@ func dataSourceNcloudVersionsRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error setting Versions: %s", err)
 	}
 
-	return nil
-
+	return writeDataSourceOutput(d, "versions")
 }
 
 func getNKSVersion(config *ProviderConfig) ([]map[string]interface{}, error) {
@@ -49,6 +49,11 @@ func dataSourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudPlacementGroup().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -104,7 +109,10 @@ func getPlacementGroupListFiltered(d *schema.ResourceData, config *ProviderConfi
 	}
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudPlacementGroup().Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, resourceNcloudPlacementGroup().Schema)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return resources, nil
@@ -78,7 +78,7 @@ func dataSourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interfa
 		return NotSupportVpc("data source `ncloud_port_forwarding_rule`")
 	}
 
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return err
 	}
@@ -77,10 +77,8 @@ func dataSourceNcloudPortForwardingRules() *schema.Resource {
 					},
 				},
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -93,7 +91,7 @@ func dataSourceNcloudPortForwardingRulesRead(d *schema.ResourceData, meta interf
 		return NotSupportVpc("data source `ncloud_port_forwarding_rules`")
 	}
 
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return err
 	}
@@ -154,9 +152,5 @@ func portForwardingRulesAttributes(d *schema.ResourceData, portForwardingConfigu
 		return err
 	}
 
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), s)
-	}
-
-	return nil
+	return writeDataSourceOutput(d, "port_forwarding_rule_list")
 }
@@ -39,7 +39,9 @@ func dataSourceNcloudPublicIp() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 
 			"public_ip_no": {
 				Type:     schema.TypeString,
@@ -65,6 +67,10 @@ func dataSourceNcloudPublicIp() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"search_filter_name": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -127,8 +133,9 @@ func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudPublicIp().Schema)
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudPublicIp().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -142,10 +149,10 @@ func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) erro
 
 func getClassicPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo := providerRegionNo(config)
 
 	reqParams := &server.GetPublicIpInstanceListRequest{
-		RegionNo: &regionNo,
+		RegionNo: regionNo,
 		ZoneNo:   StringPtrOrNil(d.GetOk("zone")),
 	}
 
@@ -157,17 +164,32 @@ func getClassicPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]m
 		reqParams.PublicIpInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getClassicPublicIpList", reqParams)
-	resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":           filterParamStringList(&reqParams.PublicIpInstanceNoList),
+		"instance_no":  filterParamStringList(&reqParams.PublicIpInstanceNoList),
+		"public_ip_no": filterParamStringList(&reqParams.PublicIpInstanceNoList),
+		"public_ip":    filterParamStringList(&reqParams.PublicIpList),
+	})
+
+	var publicIpInstanceList []*server.PublicIpInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicPublicIpList", reqParams)
+		resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicPublicIpList", err, reqParams)
+			return 0, nil, err
+		}
+		logCommonResponse("getClassicPublicIpList", GetCommonResponse(resp))
 
+		publicIpInstanceList = append(publicIpInstanceList, resp.PublicIpInstanceList...)
+		return len(resp.PublicIpInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicPublicIpList", err, reqParams)
 		return nil, err
 	}
-	logCommonResponse("getClassicPublicIpList", GetCommonResponse(resp))
 
 	var resources []map[string]interface{}
-	for _, r := range resp.PublicIpInstanceList {
+	for _, r := range publicIpInstanceList {
 		instance := map[string]interface{}{
 			"id":                 *r.PublicIpInstanceNo,
 			"instance_no":        *r.PublicIpInstanceNo,
@@ -178,6 +200,8 @@ func getClassicPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]m
 			"server_name":        nil,
 		}
 
+		SetStringIfNotNilAndEmpty(instance, "create_date", r.CreateDate)
+
 		if m := flattenCommonCode(r.PublicIpInstanceStatus); m["code"] != nil {
 			instance["status"] = m["code"]
 		}
@@ -198,7 +222,7 @@ func getClassicPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]m
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudPublicIp().Schema)
 }
 
 func getVpcPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
@@ -217,17 +241,31 @@ func getVpcPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]map[s
 		reqParams.PublicIpInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcPublicIpList", reqParams)
-	resp, err := client.vserver.V2Api.GetPublicIpInstanceList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":           filterParamStringList(&reqParams.PublicIpInstanceNoList),
+		"public_ip_no": filterParamStringList(&reqParams.PublicIpInstanceNoList),
+		"public_ip":    filterParamString(&reqParams.PublicIp),
+	})
+
+	var publicIpInstanceList []*vserver.PublicIpInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcPublicIpList", reqParams)
+		resp, err := client.vserver.V2Api.GetPublicIpInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcPublicIpList", err, reqParams)
+			return 0, nil, err
+		}
+		logCommonResponse("getVpcPublicIpList", GetCommonResponse(resp))
 
+		publicIpInstanceList = append(publicIpInstanceList, resp.PublicIpInstanceList...)
+		return len(resp.PublicIpInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcPublicIpList", err, reqParams)
 		return nil, err
 	}
-	logCommonResponse("getVpcPublicIpList", GetCommonResponse(resp))
 
 	var resources []map[string]interface{}
-	for _, r := range resp.PublicIpInstanceList {
+	for _, r := range publicIpInstanceList {
 		instance := map[string]interface{}{
 			"id":                 *r.PublicIpInstanceNo,
 			"public_ip_no":       *r.PublicIpInstanceNo,
@@ -240,6 +278,8 @@ func getVpcPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]map[s
 		SetStringIfNotNilAndEmpty(instance, "server_instance_no", r.ServerInstanceNo)
 		SetStringIfNotNilAndEmpty(instance, "server_name", r.ServerName)
 
+		SetStringIfNotNilAndEmpty(instance, "create_date", r.CreateDate)
+
 		if m := flattenCommonCode(r.PublicIpInstanceStatus); m["code"] != nil {
 			instance["status"] = m["code"]
 		}
@@ -247,5 +287,5 @@ func getVpcPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]map[s
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudPublicIp().Schema)
 }
@@ -23,16 +23,16 @@ func dataSourceNcloudRegions() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"regions": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     regionSchemaResource,
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -69,19 +69,23 @@ func dataSourceNcloudRegionsRead(d *schema.ResourceData, meta interface{}) error
 	resources := flattenRegions(regions)
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudRegions().Schema["regions"].Elem.(*schema.Resource).Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudRegions().Schema["regions"].Elem.(*schema.Resource).Schema)
+		if err != nil {
+			return err
+		}
 	}
 
-	if err := d.Set("regions", resources); err != nil {
+	resources, err = ApplySort(d, resources, dataSourceNcloudRegions().Schema["regions"].Elem.(*schema.Resource).Schema)
+	if err != nil {
 		return err
 	}
 
-	// create a json file in current directory and write d source to it.
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("regions"))
+	if err := d.Set("regions", resources); err != nil {
+		return err
 	}
 
-	return nil
+	// write the data source to `output_file` if set
+	return writeDataSourceOutput(d, "regions")
 }
 
 func getClassicRegions(d *schema.ResourceData, config *ProviderConfig) ([]*Region, error) {
@@ -51,6 +51,11 @@ func dataSourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudRouteTable().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -60,7 +65,8 @@ func dataSourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func getRouteTableList(d *schema.ResourceData, config *ProviderConfig) (*vpc.GetRouteTableListResponse, error) {
+// getRouteTableList returns the route tables and the filters left to apply after pushing them down to the request
+func getRouteTableList(d *schema.ResourceData, config *ProviderConfig) ([]*vpc.RouteTable, *schema.Set, error) {
 	reqParams := &vpc.GetRouteTableListRequest{
 		RegionCode: &config.RegionCode,
 	}
@@ -81,20 +87,34 @@ func getRouteTableList(d *schema.ResourceData, config *ProviderConfig) (*vpc.Get
 		reqParams.RouteTableNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("GetRouteTableList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetRouteTableList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":                    filterParamStringList(&reqParams.RouteTableNoList),
+		"route_table_no":        filterParamStringList(&reqParams.RouteTableNoList),
+		"vpc_no":                filterParamString(&reqParams.VpcNo),
+		"supported_subnet_type": filterParamString(&reqParams.SupportedSubnetTypeCode),
+	})
+
+	var routeTableList []*vpc.RouteTable
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetRouteTableList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetRouteTableList(reqParams)
+		if err != nil {
+			logErrorResponse("GetRouteTableList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetRouteTableList", resp)
 
+		routeTableList = append(routeTableList, resp.RouteTableList...)
+		return len(resp.RouteTableList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetRouteTableList", err, reqParams)
-		return nil, err
+		return nil, nil, err
 	}
-
-	logResponse("GetRouteTableList", resp)
-	return resp, nil
+	return routeTableList, filters, nil
 }
 
 func getRouteTableListFiltered(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
-	resp, err := getRouteTableList(d, config)
+	routeTableList, filters, err := getRouteTableList(d, config)
 
 	if err != nil {
 		return nil, err
@@ -102,7 +122,7 @@ func getRouteTableListFiltered(d *schema.ResourceData, config *ProviderConfig) (
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.RouteTableList {
+	for _, r := range routeTableList {
 		instance := map[string]interface{}{
 			"id":                    *r.RouteTableNo,
 			"route_table_no":        *r.RouteTableNo,
@@ -116,8 +136,9 @@ func getRouteTableListFiltered(d *schema.ResourceData, config *ProviderConfig) (
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudRouteTable().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudRouteTable().Schema)
+	if err != nil {
+		return nil, err
 	}
 
 	return resources, nil
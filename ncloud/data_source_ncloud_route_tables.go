@@ -28,12 +28,16 @@ func dataSourceNcloudRouteTables() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"route_tables": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudRouteTable()),
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -51,10 +55,15 @@ func dataSourceNcloudRouteTablesRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	resources, err = ApplySort(d, resources, resourceNcloudRouteTable().Schema)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("route_tables", resources); err != nil {
 		return fmt.Errorf("Error setting route tables: %s", err)
 	}
 
-	return nil
+	return writeDataSourceOutput(d, "route_tables")
 }
@@ -28,7 +28,7 @@ func dataSourceNcloudServer() *schema.Resource {
 func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*ProviderConfig)
 
-	instances, err := getServerList(d, config)
+	instances, filters, err := getServerList(d, config, dataSourceFilters(d))
 	if err != nil {
 		return err
 	}
@@ -37,9 +37,14 @@ func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
-	resources := ConvertToArrayMap(instances)
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudServer().Schema)
+	resources, err := ApplyFilters(filters, ConvertToArrayMap(instances), dataSourceNcloudServer().Schema)
+	if err != nil {
+		return err
+	}
+
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudServer().Schema)
+	if err != nil {
+		return err
 	}
 
 	if err := validateOneResult(len(resources)); err != nil {
@@ -51,16 +56,18 @@ func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func getServerList(d *schema.ResourceData, config *ProviderConfig) ([]*ServerInstance, error) {
+// getServerList returns the servers with the filters which are not applied by the request parameters
+func getServerList(d *schema.ResourceData, config *ProviderConfig, filters *schema.Set) ([]*ServerInstance, *schema.Set, error) {
 	if config.SupportVPC {
-		return getVpcServerList(d, config)
+		return getVpcServerList(d, config, filters)
 	} else {
-		return getClassicServerList(d, config)
+		list, err := getClassicServerList(d, config)
+		return list, filters, err
 	}
 }
 
 func getClassicServerList(d *schema.ResourceData, config *ProviderConfig) ([]*ServerInstance, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -73,25 +80,32 @@ func getClassicServerList(d *schema.ResourceData, config *ProviderConfig) ([]*Se
 		reqParams.ServerInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getClassicServerList", reqParams)
-	resp, err := config.Client.server.V2Api.GetServerInstanceList(reqParams)
-
+	var serverInstanceList []*server.ServerInstance
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicServerList", reqParams)
+		resp, err := config.Client.server.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicServerList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicServerList", resp)
+
+		serverInstanceList = append(serverInstanceList, resp.ServerInstanceList...)
+		return len(resp.ServerInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicServerList", err, reqParams)
 		return nil, err
 	}
 
-	logResponse("getClassicServerList", resp)
-
 	var list []*ServerInstance
-	for _, r := range resp.ServerInstanceList {
+	for _, r := range serverInstanceList {
 		list = append(list, convertClassicServerInstance(r))
 	}
 
 	return list, nil
 }
 
-func getVpcServerList(d *schema.ResourceData, config *ProviderConfig) ([]*ServerInstance, error) {
+func getVpcServerList(d *schema.ResourceData, config *ProviderConfig, filters *schema.Set) ([]*ServerInstance, *schema.Set, error) {
 	client := config.Client
 
 	reqParams := &vserver.GetServerInstanceListRequest{
@@ -102,19 +116,31 @@ func getVpcServerList(d *schema.ResourceData, config *ProviderConfig) ([]*Server
 		reqParams.ServerInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("getVpcServerList", reqParams)
-
-	resp, err := client.vserver.V2Api.GetServerInstanceList(reqParams)
+	filters = pushDownFilters(filters, filterRequestParams{
+		"vpc_no": filterParamString(&reqParams.VpcNo),
+	})
+
+	var serverInstanceList []*vserver.ServerInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcServerList", reqParams)
+		resp, err := client.vserver.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcServerList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcServerList", resp)
+
+		serverInstanceList = append(serverInstanceList, resp.ServerInstanceList...)
+		return len(resp.ServerInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcServerList", err, reqParams)
-		return nil, err
+		return nil, nil, err
 	}
-	logResponse("getVpcServerList", resp)
 
 	var list []*ServerInstance
-	for _, r := range resp.ServerInstanceList {
+	for _, r := range serverInstanceList {
 		list = append(list, convertVcpServerInstance(r))
 	}
 
-	return list, nil
+	return list, filters, nil
 }
@@ -33,7 +33,9 @@ func dataSourceNcloudServerImage() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 
 			"product_name": {
 				Type:     schema.TypeString,
@@ -88,6 +90,11 @@ func dataSourceNcloudServerImageRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudServerImage().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -111,20 +118,16 @@ func getServerImageProductListFiltered(d *schema.ResourceData, config *ProviderC
 		return nil, err
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudServerImage().Schema)
-	}
-
 	return resources, nil
 }
 
 func getClassicServerImageProductList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo := providerRegionNo(config)
 
 	reqParams := &server.GetServerImageProductListRequest{
 		ProductCode:                 StringPtrOrNil(d.GetOk("product_code")),
-		RegionNo:                    &regionNo,
+		RegionNo:                    regionNo,
 		InfraResourceDetailTypeCode: StringPtrOrNil(d.GetOk("infra_resource_detail_type_code")),
 	}
 
@@ -132,13 +135,28 @@ func getClassicServerImageProductList(d *schema.ResourceData, config *ProviderCo
 		reqParams.PlatformTypeCodeList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("GetServerImageProductList", reqParams)
-	resp, err := client.server.V2Api.GetServerImageProductList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":                              filterParamString(&reqParams.ProductCode),
+		"product_code":                    filterParamString(&reqParams.ProductCode),
+		"platform_type":                   filterParamStringList(&reqParams.PlatformTypeCodeList),
+		"infra_resource_detail_type_code": filterParamString(&reqParams.InfraResourceDetailTypeCode),
+	})
+
+	v, err := config.readCache.get(readCacheKey("server/getServerImageProductList", reqParams), func() (interface{}, error) {
+		logCommonRequest("GetServerImageProductList", reqParams)
+		resp, err := client.server.V2Api.GetServerImageProductList(reqParams)
+		if err != nil {
+			logErrorResponse("GetServerImageProductList", err, reqParams)
+			return nil, err
+		}
+		logResponse("GetServerImageProductList", resp)
+
+		return resp, nil
+	})
 	if err != nil {
-		logErrorResponse("GetServerImageProductList", err, reqParams)
 		return nil, err
 	}
-	logResponse("GetServerImageProductList", resp)
+	resp := v.(*server.GetServerImageProductListResponse)
 
 	var resources []map[string]interface{}
 
@@ -162,7 +180,7 @@ func getClassicServerImageProductList(d *schema.ResourceData, config *ProviderCo
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudServerImage().Schema)
 }
 
 func getVpcServerImageProductList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
@@ -178,13 +196,27 @@ func getVpcServerImageProductList(d *schema.ResourceData, config *ProviderConfig
 		reqParams.PlatformTypeCodeList = []*string{ncloud.String(v.(string))}
 	}
 
-	logCommonRequest("GetServerImageProductList", reqParams)
-	resp, err := client.vserver.V2Api.GetServerImageProductList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":            filterParamString(&reqParams.ProductCode),
+		"product_code":  filterParamString(&reqParams.ProductCode),
+		"platform_type": filterParamStringList(&reqParams.PlatformTypeCodeList),
+	})
+
+	v, err := config.readCache.get(readCacheKey("vserver/getServerImageProductList", reqParams), func() (interface{}, error) {
+		logCommonRequest("GetServerImageProductList", reqParams)
+		resp, err := client.vserver.V2Api.GetServerImageProductList(reqParams)
+		if err != nil {
+			logErrorResponse("GetServerImageProductList", err, reqParams)
+			return nil, err
+		}
+		logResponse("GetServerImageProductList", resp)
+
+		return resp, nil
+	})
 	if err != nil {
-		logErrorResponse("GetServerImageProductList", err, reqParams)
 		return nil, err
 	}
-	logResponse("GetServerImageProductList", resp)
+	resp := v.(*vserver.GetServerImageProductListResponse)
 
 	var resources []map[string]interface{}
 
@@ -207,5 +239,5 @@ func getVpcServerImageProductList(d *schema.ResourceData, config *ProviderConfig
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudServerImage().Schema)
 }
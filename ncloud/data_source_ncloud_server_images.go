@@ -31,11 +31,11 @@ func dataSourceNcloudServerImages() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"filter": dataSourceFiltersSchema(),
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
+			"filter":        dataSourceFiltersSchema(),
+			"most_recent":   dataSourceMostRecentSchema(),
+			"sort_by":       dataSourceSortBySchema(),
 
 			"server_images": {
 				Type:     schema.TypeList,
@@ -86,6 +86,11 @@ func dataSourceNcloudServerImagesRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	resources, err = ApplySort(d, resources, dataSourceNcloudServerImage().Schema)
+	if err != nil {
+		return err
+	}
+
 	return serverImagesAttributes(d, resources)
 }
 
@@ -104,9 +109,5 @@ func serverImagesAttributes(d *schema.ResourceData, resources []map[string]inter
 	d.Set("ids", ids)
 	d.Set("server_images", resources)
 
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("server_images"))
-	}
-
-	return nil
+	return writeDataSourceOutput(d, "server_images")
 }
@@ -38,7 +38,9 @@ func dataSourceNcloudServerProduct() *schema.Resource {
 				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"PUBLC", "GLBL"}, false)),
 				Deprecated:       "This parameter is no longer used.",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 
 			"product_name": {
 				Type:     schema.TypeString,
@@ -99,6 +101,11 @@ func dataSourceNcloudServerProductRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudServerProduct().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 
 		return err
@@ -123,16 +130,12 @@ func getServerProductListFiltered(d *schema.ResourceData, config *ProviderConfig
 		return nil, err
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudServerProduct().Schema)
-	}
-
 	return resources, nil
 }
 
 func getClassicServerProductList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo := providerRegionNo(config)
 
 	zoneNo, err := parseZoneNoParameter(config, d)
 	if err != nil {
@@ -142,17 +145,31 @@ func getClassicServerProductList(d *schema.ResourceData, config *ProviderConfig)
 		ExclusionProductCode:   StringPtrOrNil(d.GetOk("exclusion_product_code")),
 		ServerImageProductCode: ncloud.String(d.Get("server_image_product_code").(string)),
 		ProductCode:            StringPtrOrNil(d.GetOk("product_code")),
-		RegionNo:               &regionNo,
+		RegionNo:               regionNo,
 		ZoneNo:                 zoneNo,
 	}
 
-	logCommonRequest("getClassicServerProductList", reqParams)
-	resp, err := client.server.V2Api.GetServerProductList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":              filterParamString(&reqParams.ProductCode),
+		"product_code":    filterParamString(&reqParams.ProductCode),
+		"generation_code": filterParamString(&reqParams.GenerationCode),
+	})
+
+	v, err := config.readCache.get(readCacheKey("server/getServerProductList", reqParams), func() (interface{}, error) {
+		logCommonRequest("getClassicServerProductList", reqParams)
+		resp, err := client.server.V2Api.GetServerProductList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicServerProductList", err, reqParams)
+			return nil, err
+		}
+		logResponse("getClassicServerProductList", resp)
+
+		return resp, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicServerProductList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicServerProductList", resp)
+	resp := v.(*server.GetServerProductListResponse)
 
 	var resources []map[string]interface{}
 
@@ -174,7 +191,7 @@ func getClassicServerProductList(d *schema.ResourceData, config *ProviderConfig)
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudServerProduct().Schema)
 }
 
 func getVpcServerProductList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
@@ -189,13 +206,27 @@ func getVpcServerProductList(d *schema.ResourceData, config *ProviderConfig) ([]
 		ZoneCode:               StringPtrOrNil(d.GetOk("zone")),
 	}
 
-	logCommonRequest("getVpcServerProductList", reqParams)
-	resp, err := client.vserver.V2Api.GetServerProductList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":              filterParamString(&reqParams.ProductCode),
+		"product_code":    filterParamString(&reqParams.ProductCode),
+		"generation_code": filterParamString(&reqParams.GenerationCode),
+	})
+
+	v, err := config.readCache.get(readCacheKey("vserver/getServerProductList", reqParams), func() (interface{}, error) {
+		logCommonRequest("getVpcServerProductList", reqParams)
+		resp, err := client.vserver.V2Api.GetServerProductList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcServerProductList", err, reqParams)
+			return nil, err
+		}
+		logResponse("getVpcServerProductList", resp)
+
+		return resp, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcServerProductList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcServerProductList", resp)
+	resp := v.(*vserver.GetServerProductListResponse)
 
 	var resources []map[string]interface{}
 
@@ -217,5 +248,5 @@ func getVpcServerProductList(d *schema.ResourceData, config *ProviderConfig) ([]
 		resources = append(resources, instance)
 	}
 
-	return resources, nil
+	return ApplyFilters(filters, resources, dataSourceNcloudServerProduct().Schema)
 }
@@ -46,11 +46,11 @@ func dataSourceNcloudServerProducts() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": dataSourceFiltersSchema(),
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"filter":        dataSourceFiltersSchema(),
+			"most_recent":   dataSourceMostRecentSchema(),
+			"sort_by":       dataSourceSortBySchema(),
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 			// Deprecated
 			"product_name_regex": {
 				Type:             schema.TypeString,
@@ -82,14 +82,15 @@ func dataSourceNcloudServerProductsRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudServerProduct().Schema)
-	}
-
 	if len(resources) < 1 {
 		return fmt.Errorf("no results. please change search criteria and try again")
 	}
 
+	resources, err = ApplySort(d, resources, dataSourceNcloudServerProduct().Schema)
+	if err != nil {
+		return err
+	}
+
 	return serverProductsAttributes(d, resources)
 }
 
@@ -108,9 +109,5 @@ func serverProductsAttributes(d *schema.ResourceData, serverProduct []map[string
 	d.Set("ids", ids)
 	d.Set("server_products", serverProduct)
 
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("server_products"))
-	}
-
-	return nil
+	return writeDataSourceOutput(d, "server_products")
 }
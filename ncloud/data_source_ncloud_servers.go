@@ -0,0 +1,177 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func init() {
+	RegisterDataSource("ncloud_servers", dataSourceNcloudServers())
+}
+
+func dataSourceNcloudServers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudServersRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the VPC which the servers belong to",
+			},
+			"subnet_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the subnet which the servers belong to",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Zone code of the servers",
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Name pattern of the servers. `*` matches any characters. e.g. `web-*`",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags which the servers must have. A value of `*` matches any value of the key",
+			},
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     GetDataSourceItemSchema(resourceNcloudServer()),
+			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
+		},
+	}
+}
+
+func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ProviderConfig)
+
+	// vpc_no is applied by the request parameter, and the name patterns and tags are matched locally
+	instances, filters, err := getServerList(d, config, serverListFilters(d))
+	if err != nil {
+		return err
+	}
+
+	itemSchema := dataSourceNcloudServers().Schema["servers"].Elem.(*schema.Resource).Schema
+
+	var resources []map[string]interface{}
+	for _, instance := range instances {
+		m := ConvertToMap(instance)
+		m["id"] = m["instance_no"]
+		// Only the classic servers have tags
+		if instance.InstanceTagList != nil {
+			m["tags_all"] = flattenInstanceTagMap(instance.InstanceTagList)
+		}
+
+		resources = append(resources, m)
+	}
+
+	resources, err = ApplyFilters(filters, resources, itemSchema)
+	if err != nil {
+		return err
+	}
+
+	if len(resources) < 1 {
+		return fmt.Errorf("no results. please change search criteria and try again")
+	}
+
+	resources, err = ApplySort(d, resources, itemSchema)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, r := range resources {
+		// Only the attributes of the schema can be set
+		for k := range r {
+			if _, ok := itemSchema[k]; !ok {
+				delete(r, k)
+			}
+		}
+		ids = append(ids, r["id"].(string))
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("servers", resources); err != nil {
+		return fmt.Errorf("error setting servers: %s", err)
+	}
+
+	return writeDataSourceOutput(d, "servers")
+}
+
+// serverListFilters returns the `filter` blocks with the filters of the arguments
+func serverListFilters(d *schema.ResourceData) *schema.Set {
+	filters := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), nil)
+	if f := dataSourceFilters(d); f != nil {
+		for _, v := range f.List() {
+			filters.Add(v)
+		}
+	}
+
+	add := func(name string, value string, regex bool) {
+		filters.Add(map[string]interface{}{
+			"name":      name,
+			"values":    []interface{}{value},
+			"regex":     regex,
+			"operator":  filterOperatorEq,
+			"match_all": false,
+		})
+	}
+
+	for _, name := range []string{"vpc_no", "subnet_no", "zone"} {
+		if v, ok := d.GetOk(name); ok {
+			add(name, v.(string), false)
+		}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		add("name", namePatternToRegex(v.(string)), true)
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			if value.(string) == "*" {
+				add("tags_all."+key, ".*", true)
+			} else {
+				add("tags_all."+key, value.(string), false)
+			}
+		}
+	}
+
+	return filters
+}
+
+// namePatternToRegex converts the name pattern, where `*` matches any characters, to a regular expression
+func namePatternToRegex(pattern string) string {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return "^" + strings.Join(parts, ".*") + "$"
+}
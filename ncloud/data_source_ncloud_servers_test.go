@@ -0,0 +1,199 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNcloudServers_vpc_basic(t *testing.T) {
+	dataName := "data.ncloud_servers.by_subnet"
+	resourceName := "ncloud_server.server"
+	testServerName := getTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceServersVpcConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttr(dataName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataName, "servers.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataName, "servers.0.subnet_no", resourceName, "subnet_no"),
+					resource.TestCheckResourceAttrPair(dataName, "servers.0.vpc_no", resourceName, "vpc_no"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceServersVpcConfig(testServerName string) string {
+	return testAccDataSourceServerVpcConfig(testServerName) + fmt.Sprintf(`
+data "ncloud_servers" "by_subnet" {
+	subnet_no = ncloud_server.server.subnet_no
+	name      = "%[1]s*"
+}
+`, testServerName)
+}
+
+func TestDataSourceNcloudServers_vpc_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_server"]
+
+	vpcNo, subnetNo := e.seedVpc("10.5.0.0/16", "10.5.0.0/24")
+	otherSubnetNo := e.seedSubnet(vpcNo, "10.5.1.0/24", "PRIVATE", "GEN")
+	loginKey := testEmulatorApply(t, p.ResourcesMap["ncloud_login_key"], nil, map[string]interface{}{
+		"key_name": "tf-emulator-key",
+	}, config)
+
+	servers := map[string]string{}
+	for _, s := range []struct{ name, subnetNo string }{
+		{"web-1", subnetNo},
+		{"web-2", otherSubnetNo},
+		{"db-1", subnetNo},
+	} {
+		state := testEmulatorApply(t, r, nil, map[string]interface{}{
+			"subnet_no":                 s.subnetNo,
+			"name":                      s.name,
+			"server_image_product_code": "SW.VSVR.OS.LNX64.CNTOS.0703.B050",
+			"server_product_code":       "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002",
+			"login_key_name":            loginKey.ID,
+		}, config)
+		servers[s.name] = state.ID
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected []string
+	}{
+		{"name pattern", map[string]interface{}{"name": "web-*"}, []string{servers["web-1"], servers["web-2"]}},
+		{"subnet", map[string]interface{}{"subnet_no": subnetNo, "vpc_no": vpcNo}, []string{servers["web-1"], servers["db-1"]}},
+		{"name pattern and subnet", map[string]interface{}{"name": "web-*", "subnet_no": subnetNo, "zone": "KR-1"}, []string{servers["web-1"]}},
+		{"sort", map[string]interface{}{"sort_by": []interface{}{map[string]interface{}{"field": "name", "order": "desc"}}}, []string{servers["web-2"], servers["web-1"], servers["db-1"]}},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, tc.raw)
+		if err := dataSourceNcloudServersRead(d, config); err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+			continue
+		}
+
+		if actual := fmt.Sprint(d.Get("ids")); actual != fmt.Sprint(tc.expected) {
+			t.Errorf("%s: Expected ids %s, Actual %s", tc.name, fmt.Sprint(tc.expected), actual)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, map[string]interface{}{"name": "db-*"})
+	if err := dataSourceNcloudServersRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("servers.0.name") != "db-1" || d.Get("servers.0.subnet_no") != subnetNo || d.Get("servers.0.vpc_no") != vpcNo {
+		t.Fatalf("Expected the attributes of db-1, got %v", d.Get("servers.0"))
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, map[string]interface{}{"name": "app-*"})
+	if err := dataSourceNcloudServersRead(d, config); err == nil {
+		t.Fatal("Expected no results error")
+	}
+
+	// vpc_no is sent with the request, while the name pattern is matched locally
+	d = schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, map[string]interface{}{"vpc_no": vpcNo, "name": "web-*"})
+	if err := dataSourceNcloudServersRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if form := e.lastForm("vserver", "getServerInstanceList"); form.Get("vpcNo") != vpcNo || form.Get("serverName") != "" {
+		t.Fatalf("Expected only vpcNo to be sent, got %v", form)
+	}
+	if actual := fmt.Sprint(d.Get("ids")); actual != fmt.Sprint([]string{servers["web-1"], servers["web-2"]}) {
+		t.Fatalf("Expected ids of web servers, Actual %s", actual)
+	}
+}
+
+func TestDataSourceNcloudServers_classic_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, false)
+	r := p.ResourcesMap["ncloud_server"]
+
+	loginKey := testEmulatorApply(t, p.ResourcesMap["ncloud_login_key"], nil, map[string]interface{}{
+		"key_name": "tf-emulator-key",
+	}, config)
+
+	servers := map[string]string{}
+	for _, s := range []struct{ name, env string }{
+		{"web-1", "prod"},
+		{"web-2", "dev"},
+	} {
+		state := testEmulatorApply(t, r, nil, map[string]interface{}{
+			"name":                      s.name,
+			"server_image_product_code": "SPSW0LINUX000045",
+			"server_product_code":       "SPSVRSTAND000004",
+			"login_key_name":            loginKey.ID,
+			"zone":                      "KR-2",
+			"tag_list": []interface{}{
+				map[string]interface{}{"tag_key": "env", "tag_value": s.env},
+			},
+		}, config)
+		servers[s.name] = state.ID
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected []string
+	}{
+		{"tag value", map[string]interface{}{"tags": map[string]interface{}{"env": "prod"}}, []string{servers["web-1"]}},
+		{"tag key", map[string]interface{}{"tags": map[string]interface{}{"env": "*"}, "zone": "KR-2"}, []string{servers["web-1"], servers["web-2"]}},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, tc.raw)
+		if err := dataSourceNcloudServersRead(d, config); err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+			continue
+		}
+
+		if actual := fmt.Sprint(d.Get("ids")); actual != fmt.Sprint(tc.expected) {
+			t.Errorf("%s: Expected ids %s, Actual %s", tc.name, fmt.Sprint(tc.expected), actual)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, map[string]interface{}{"tags": map[string]interface{}{"env": "dev"}})
+	if err := dataSourceNcloudServersRead(d, config); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("servers.0.name") != "web-2" || d.Get("servers.0.tags_all.env") != "dev" {
+		t.Fatalf("Expected the tags of web-2, got %v", d.Get("servers.0"))
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceNcloudServers().Schema, map[string]interface{}{"tags": map[string]interface{}{"owner": "*"}})
+	if err := dataSourceNcloudServersRead(d, config); err == nil {
+		t.Fatal("Expected no results error")
+	}
+}
+
+func TestNamePatternToRegex(t *testing.T) {
+	cases := map[string]string{
+		"web-*":    "^web-.*$",
+		"*.prod":   "^.*\\.prod$",
+		"db":       "^db$",
+		"a*b*(c)*": "^a.*b.*\\(c\\).*$",
+	}
+
+	for pattern, expected := range cases {
+		if actual := namePatternToRegex(pattern); actual != expected {
+			t.Errorf("%s: Expected %s, Actual %s", pattern, expected, actual)
+		}
+	}
+}
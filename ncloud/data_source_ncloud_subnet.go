@@ -69,6 +69,11 @@ func dataSourceNcloudSubnetRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudSubnet().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -111,18 +116,37 @@ func getSubnetListFiltered(d *schema.ResourceData, config *ProviderConfig) ([]ma
 		reqParams.UsageTypeCode = ncloud.String(v.(string))
 	}
 
-	logCommonRequest("GetSubnetList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetSubnetList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":             filterParamStringList(&reqParams.SubnetNoList),
+		"subnet_no":      filterParamStringList(&reqParams.SubnetNoList),
+		"vpc_no":         filterParamString(&reqParams.VpcNo),
+		"subnet":         filterParamString(&reqParams.Subnet),
+		"zone":           filterParamString(&reqParams.ZoneCode),
+		"network_acl_no": filterParamString(&reqParams.NetworkAclNo),
+		"subnet_type":    filterParamString(&reqParams.SubnetTypeCode),
+		"usage_type":     filterParamString(&reqParams.UsageTypeCode),
+	})
+
+	var subnetList []*vpc.Subnet
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetSubnetList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetSubnetList(reqParams)
+		if err != nil {
+			logErrorResponse("GetSubnetList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetSubnetList", resp)
 
+		subnetList = append(subnetList, resp.SubnetList...)
+		return len(resp.SubnetList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetSubnetList", err, reqParams)
 		return nil, err
 	}
-	logResponse("GetSubnetList", resp)
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.SubnetList {
+	for _, r := range subnetList {
 		instance := map[string]interface{}{
 			"id":             *r.SubnetNo,
 			"subnet_no":      *r.SubnetNo,
@@ -138,8 +162,9 @@ func getSubnetListFiltered(d *schema.ResourceData, config *ProviderConfig) ([]ma
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudSubnet().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudSubnet().Schema)
+	if err != nil {
+		return nil, err
 	}
 
 	return resources, nil
@@ -55,12 +55,16 @@ func dataSourceNcloudSubnets() *schema.Resource {
 				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"GEN", "LOADB", "BM"}, false)),
 				Description:      "Usage type. GEN(Normal), LOADB(Load Balance), BM(BareMetal). default : GEN(Normal).",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"subnets": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudSubnet()),
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -78,10 +82,15 @@ func dataSourceNcloudSubnetsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	resources, err = ApplySort(d, resources, resourceNcloudSubnet().Schema)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("subnets", resources); err != nil {
 		return fmt.Errorf("Error setting Subnets: %s", err)
 	}
 
-	return nil
+	return writeDataSourceOutput(d, "subnets")
 }
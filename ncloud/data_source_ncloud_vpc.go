@@ -41,6 +41,11 @@ func dataSourceNcloudVpcRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudVpc().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -63,6 +68,11 @@ func getVpcListFiltered(d *schema.ResourceData, config *ProviderConfig) ([]map[s
 		reqParams.VpcNoList = []*string{ncloud.String(v.(string))}
 	}
 
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":     filterParamStringList(&reqParams.VpcNoList),
+		"vpc_no": filterParamStringList(&reqParams.VpcNoList),
+	})
+
 	logCommonRequest("GetVpcList", reqParams)
 	resp, err := config.Client.vpc.V2Api.GetVpcList(reqParams)
 
@@ -85,8 +95,9 @@ func getVpcListFiltered(d *schema.ResourceData, config *ProviderConfig) ([]map[s
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudVpc().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudVpc().Schema)
+	if err != nil {
+		return nil, err
 	}
 
 	return resources, nil
@@ -50,6 +50,11 @@ func dataSourceNcloudVpcPeeringRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	resources, err = ApplySingularSort(d, resources, dataSourceNcloudVpcPeering().Schema)
+	if err != nil {
+		return err
+	}
+
 	if err := validateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -80,18 +85,31 @@ func getVpcPeeringListFiltered(d *schema.ResourceData, config *ProviderConfig) (
 		reqParams.SourceVpcName = ncloud.String(v.(string))
 	}
 
-	logCommonRequest("GetVpcPeeringInstanceList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetVpcPeeringInstanceList(reqParams)
+	filters := pushDownFilters(dataSourceFilters(d), filterRequestParams{
+		"id":             filterParamStringList(&reqParams.VpcPeeringInstanceNoList),
+		"vpc_peering_no": filterParamStringList(&reqParams.VpcPeeringInstanceNoList),
+	})
+
+	var vpcPeeringInstanceList []*vpc.VpcPeeringInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetVpcPeeringInstanceList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetVpcPeeringInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetVpcPeeringInstanceList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetVpcPeeringInstanceList", resp)
 
+		vpcPeeringInstanceList = append(vpcPeeringInstanceList, resp.VpcPeeringInstanceList...)
+		return len(resp.VpcPeeringInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetVpcPeeringInstanceList", err, reqParams)
 		return nil, err
 	}
-	logResponse("GetVpcPeeringInstanceList", resp)
 
 	resources := []map[string]interface{}{}
 
-	for _, r := range resp.VpcPeeringInstanceList {
+	for _, r := range vpcPeeringInstanceList {
 		instance := map[string]interface{}{
 			"id":                      *r.VpcPeeringInstanceNo,
 			"vpc_peering_no":          *r.VpcPeeringInstanceNo,
@@ -108,8 +126,9 @@ func getVpcPeeringListFiltered(d *schema.ResourceData, config *ProviderConfig) (
 		resources = append(resources, instance)
 	}
 
-	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, resourceNcloudVpcPeering().Schema)
+	resources, err = ApplyFilters(filters, resources, resourceNcloudVpcPeering().Schema)
+	if err != nil {
+		return nil, err
 	}
 
 	return resources, nil
@@ -24,12 +24,16 @@ func dataSourceNcloudVpcs() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"vpcs": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     GetDataSourceItemSchema(resourceNcloudVpc()),
 			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -47,10 +51,15 @@ func dataSourceNcloudVpcsRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	resources, err = ApplySort(d, resources, resourceNcloudVpc().Schema)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("vpcs", resources); err != nil {
 		return fmt.Errorf("Error setting vpcs: %s", err)
 	}
 
-	return nil
+	return writeDataSourceOutput(d, "vpcs")
 }
@@ -23,16 +23,16 @@ func dataSourceNcloudZones() *schema.Resource {
 				Optional:    true,
 				Description: "Region code. Get available values using the `data ncloud_regions`.",
 			},
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
+			"sort_by":     dataSourceSortBySchema(),
 			"zones": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     zoneSchemaResource,
 			},
-			"output_file": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
+			"output_file":   dataSourceOutputFileSchema(),
+			"output_format": dataSourceOutputFormatSchema(),
 		},
 	}
 }
@@ -56,29 +56,37 @@ func dataSourceNcloudZonesRead(d *schema.ResourceData, meta interface{}) error {
 	resources := flattenZones(zones)
 
 	if f, ok := d.GetOk("filter"); ok {
-		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudZones().Schema["zones"].Elem.(*schema.Resource).Schema)
+		resources, err = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudZones().Schema["zones"].Elem.(*schema.Resource).Schema)
+		if err != nil {
+			return err
+		}
 	}
 
-	if err := d.Set("zones", resources); err != nil {
+	resources, err = ApplySort(d, resources, dataSourceNcloudZones().Schema["zones"].Elem.(*schema.Resource).Schema)
+	if err != nil {
 		return err
 	}
 
-	// create a json file in current directory and write d source to it.
-	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), d.Get("zones"))
+	if err := d.Set("zones", resources); err != nil {
+		return err
 	}
 
-	return nil
+	// write the data source to `output_file` if set
+	return writeDataSourceOutput(d, "zones")
 }
 
 func getClassicZones(config *ProviderConfig) ([]*Zone, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo := providerRegionNo(config)
 
-	resp, err := client.server.V2Api.GetZoneList(&server.GetZoneListRequest{RegionNo: &regionNo})
+	reqParams := &server.GetZoneListRequest{RegionNo: regionNo}
+	v, err := config.readCache.get(readCacheKey("server/getZoneList", reqParams), func() (interface{}, error) {
+		return client.server.V2Api.GetZoneList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
+	resp := v.(*server.GetZoneListResponse)
 
 	if resp == nil {
 		return nil, fmt.Errorf("no matching zones found")
@@ -97,10 +105,14 @@ func getVpcZones(config *ProviderConfig) ([]*Zone, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
-	resp, err := client.vserver.V2Api.GetZoneList(&vserver.GetZoneListRequest{RegionCode: &regionCode})
+	reqParams := &vserver.GetZoneListRequest{RegionCode: &regionCode}
+	v, err := config.readCache.get(readCacheKey("vserver/getZoneList", reqParams), func() (interface{}, error) {
+		return client.vserver.V2Api.GetZoneList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
+	resp := v.(*vserver.GetZoneListResponse)
 
 	if resp == nil {
 		return nil, fmt.Errorf("no matching zones found")
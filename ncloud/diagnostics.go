@@ -0,0 +1,44 @@
+package ncloud
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// diagFromErr converts err into diagnostics.
+// An NCP API error is decoded into a readable summary and a detail with its returnCode, returnMessage and remediation hint.
+// An attributeError, or a well-known API error caused by an argument, is reported with the path of the attribute.
+func diagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}
+
+	var attrErr *attributeError
+	if errors.As(err, &attrErr) {
+		diagnostic.AttributePath = cty.GetAttrPath(attrErr.Attribute)
+	}
+
+	var readOnlyErr *readOnlyError
+	if errors.As(err, &readOnlyErr) {
+		diagnostic.Summary = "The provider is read only"
+		diagnostic.Detail = fmt.Sprintf("The %s API %q may change resources, so it was not sent. Unset `read_only` of the provider or NCLOUD_READ_ONLY to apply changes.", readOnlyErr.Service, readOnlyErr.Operation)
+	}
+
+	if apiErr, ok := decodeApiError(err); ok {
+		diagnostic.Summary = apiErr.Summary()
+		diagnostic.Detail = apiErr.Detail()
+		if explanation := apiErr.Explanation(); explanation != nil && explanation.Attribute != "" && diagnostic.AttributePath == nil {
+			diagnostic.AttributePath = cty.GetAttrPath(explanation.Attribute)
+		}
+	}
+
+	return diag.Diagnostics{diagnostic}
+}
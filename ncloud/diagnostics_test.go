@@ -0,0 +1,60 @@
+package ncloud
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestDiagFromErr_apiError(t *testing.T) {
+	err := fmt.Errorf(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "1300", "returnMessage": "Server instance not found."}}`)
+
+	diags := diagFromErr(err)
+
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("expected a single error, got %#v", diags)
+	}
+	if diags[0].Summary != "Server instance not found." {
+		t.Fatalf("Expected: %s, Actual: %s", "Server instance not found.", diags[0].Summary)
+	}
+	expected := "returnCode: 1300, returnMessage: Server instance not found.\nHTTP status: 400 Bad Request"
+	if diags[0].Detail != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, diags[0].Detail)
+	}
+}
+
+func TestDiagFromErr_nameInUse(t *testing.T) {
+	err := fmt.Errorf(`error creating VPC: Status: 400 Bad Request, Body: {"responseError": {"returnCode": "1000030", "returnMessage": "The VPC name is already in use."}}`)
+
+	diags := diagFromErr(err)
+
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("name")) {
+		t.Fatalf("expected attribute path name, got %#v", diags)
+	}
+	expected := "error creating VPC: Name already in use: The VPC name is already in use."
+	if diags[0].Summary != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, diags[0].Summary)
+	}
+}
+
+func TestDiagFromErr_plainError(t *testing.T) {
+	diags := diagFromErr(errors.New("not found subnet"))
+
+	if len(diags) != 1 || diags[0].Summary != "not found subnet" || diags[0].Detail != "" {
+		t.Fatalf("expected the error message as summary only, got %#v", diags)
+	}
+	if diagFromErr(nil) != nil {
+		t.Fatal("expected no diagnostics for nil error")
+	}
+}
+
+func TestDiagFromErr_attributePath(t *testing.T) {
+	diags := diagFromErr(fmt.Errorf("validation: %w", ErrorRequiredArgOnVpc("subnet_no")))
+
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("subnet_no")) {
+		t.Fatalf("expected attribute path subnet_no, got %#v", diags)
+	}
+}
@@ -0,0 +1,452 @@
+package ncloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+func init() {
+	emulatorServiceRegistrations = append(emulatorServiceRegistrations,
+		registerEmulatorVnas,
+		registerEmulatorVloadbalancer,
+		registerEmulatorVautoscaling,
+	)
+}
+
+func vnasCode(code string) *vnas.CommonCode {
+	return &vnas.CommonCode{Code: ncloud.String(code), CodeName: ncloud.String(code)}
+}
+
+func registerEmulatorVnas(e *testEmulator) {
+	e.handle("vnas", "createNasVolumeInstance", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vnas.CreateNasVolumeInstanceRequest{}
+		decodeEmulatorForm(form, req)
+
+		size := int64(ncloud.Int32Value(req.VolumeSize))
+		if size < 500 || size > 10000 {
+			return nil, newEmulatorError("10103", "NAS volume size must be between 500GB and 10000GB")
+		}
+
+		n := &vnas.NasVolumeInstance{
+			NasVolumeInstanceNo:         ncloud.String(e.nextNo()),
+			NasVolumeInstanceStatus:     vnasCode("INIT"),
+			NasVolumeInstanceOperation:  vnasCode("NULL"),
+			CreateDate:                  emulatorNow(),
+			NasVolumeDescription:        req.NasVolumeDescription,
+			VolumeAllotmentProtocolType: vnasCode(ncloud.StringValue(req.VolumeAllotmentProtocolTypeCode)),
+			VolumeName:                  ncloud.String("n000001_" + ncloud.StringValue(req.VolumeName)),
+			VolumeTotalSize:             ncloud.Int64(size * GIGABYTE),
+			VolumeSize:                  ncloud.Int64(size * GIGABYTE),
+			SnapshotVolumeSize:          ncloud.Int64(0),
+			IsSnapshotConfiguration:     ncloud.Bool(false),
+			IsEventConfiguration:        ncloud.Bool(false),
+			RegionCode:                  ncloud.String(emulatorRegionCode),
+			ZoneCode:                    req.ZoneCode,
+			IsEncryptedVolume:           ncloud.Bool(ncloud.BoolValue(req.IsEncryptedVolume)),
+			IsReturnProtection:          ncloud.Bool(false),
+		}
+		if n.ZoneCode == nil {
+			n.ZoneCode = ncloud.String(emulatorZones[0])
+		}
+		n.MountInformation = ncloud.String(fmt.Sprintf("169.254.0.1:/%s", *n.VolumeName))
+		n.NasVolumeServerInstanceNoList = req.ServerInstanceNoList
+
+		e.put("nasVolume", *n.NasVolumeInstanceNo, n, func() { n.NasVolumeInstanceStatus = vnasCode("CREAT") })
+
+		return &vnas.CreateNasVolumeInstanceResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), NasVolumeInstanceList: []*vnas.NasVolumeInstance{n}}, nil
+	})
+
+	e.handle("vnas", "getNasVolumeInstanceDetail", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vnas.GetNasVolumeInstanceDetailResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(0)}
+		if n, ok := e.get("nasVolume", form.Get("nasVolumeInstanceNo")).(*vnas.NasVolumeInstance); ok {
+			resp.NasVolumeInstanceList = []*vnas.NasVolumeInstance{n}
+			resp.TotalRows = ncloud.Int32(1)
+		}
+		return resp, nil
+	})
+
+	e.handle("vnas", "getNasVolumeInstanceList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vnas.GetNasVolumeInstanceListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("nasVolume", func(o interface{}) bool {
+			n := o.(*vnas.NasVolumeInstance)
+			return formMatchList(form, "nasVolumeInstanceNoList", n.NasVolumeInstanceNo) &&
+				formMatch(form, "zoneCode", n.ZoneCode) &&
+				formMatch(form, "volumeName", n.VolumeName)
+		}) {
+			resp.NasVolumeInstanceList = append(resp.NasVolumeInstanceList, o.(*vnas.NasVolumeInstance))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.NasVolumeInstanceList)))
+		start, end := formPage(form, len(resp.NasVolumeInstanceList))
+		resp.NasVolumeInstanceList = resp.NasVolumeInstanceList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vnas", "changeNasVolumeSize", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vnas.ChangeNasVolumeSizeRequest{}
+		decodeEmulatorForm(form, req)
+
+		n, ok := e.peek("nasVolume", ncloud.StringValue(req.NasVolumeInstanceNo)).(*vnas.NasVolumeInstance)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "NAS volume [%s] not found", ncloud.StringValue(req.NasVolumeInstanceNo))
+		}
+
+		n.VolumeSize = ncloud.Int64(int64(ncloud.Int32Value(req.VolumeSize)) * GIGABYTE)
+		n.VolumeTotalSize = n.VolumeSize
+
+		return &vnas.ChangeNasVolumeSizeResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), NasVolumeInstanceList: []*vnas.NasVolumeInstance{n}}, nil
+	})
+
+	e.handle("vnas", "setNasVolumeAccessControl", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vnas.SetNasVolumeAccessControlRequest{}
+		decodeEmulatorForm(form, req)
+
+		n, ok := e.peek("nasVolume", ncloud.StringValue(req.NasVolumeInstanceNo)).(*vnas.NasVolumeInstance)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "NAS volume [%s] not found", ncloud.StringValue(req.NasVolumeInstanceNo))
+		}
+
+		n.NasVolumeServerInstanceNoList = req.ServerInstanceNoList
+
+		return &vnas.SetNasVolumeAccessControlResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), NasVolumeInstanceList: []*vnas.NasVolumeInstance{n}}, nil
+	})
+
+	e.handle("vnas", "deleteNasVolumeInstances", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vnas.DeleteNasVolumeInstancesResponse{ReturnCode: ncloud.String("0")}
+		for _, no := range formList(form, "nasVolumeInstanceNoList") {
+			n, ok := e.peek("nasVolume", no).(*vnas.NasVolumeInstance)
+			if !ok {
+				return nil, newEmulatorError(emulatorErrNotFound, "NAS volume [%s] not found", no)
+			}
+
+			n.NasVolumeInstanceOperation = vnasCode("TERMT")
+			e.transition("nasVolume", no, e.removeStep("nasVolume", no))
+			resp.NasVolumeInstanceList = append(resp.NasVolumeInstanceList, n)
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.NasVolumeInstanceList)))
+		start, end := formPage(form, len(resp.NasVolumeInstanceList))
+		resp.NasVolumeInstanceList = resp.NasVolumeInstanceList[start:end]
+		return resp, nil
+	})
+}
+
+func vloadbalancerCode(code string) *vloadbalancer.CommonCode {
+	return &vloadbalancer.CommonCode{Code: ncloud.String(code), CodeName: ncloud.String(code)}
+}
+
+func registerEmulatorVloadbalancer(e *testEmulator) {
+	e.handle("vloadbalancer", "createTargetGroup", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vloadbalancer.CreateTargetGroupRequest{}
+		decodeEmulatorForm(form, req)
+
+		if _, ok := e.peek("vpc", ncloud.StringValue(req.VpcNo)).(*vpc.Vpc); !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "VPC [%s] not found", ncloud.StringValue(req.VpcNo))
+		}
+
+		protocol := ncloud.StringValue(req.TargetGroupProtocolTypeCode)
+		tg := &vloadbalancer.TargetGroup{
+			TargetGroupNo:             ncloud.String(e.nextNo()),
+			TargetGroupName:           req.TargetGroupName,
+			TargetType:                vloadbalancerCode(ncloud.StringValue(req.TargetTypeCode)),
+			VpcNo:                     req.VpcNo,
+			TargetGroupProtocolType:   vloadbalancerCode(protocol),
+			TargetGroupPort:           req.TargetGroupPort,
+			TargetGroupDescription:    req.TargetGroupDescription,
+			UseStickySession:          ncloud.Bool(false),
+			UseProxyProtocol:          ncloud.Bool(false),
+			AlgorithmType:             vloadbalancerCode("RR"),
+			CreateDate:                emulatorNow(),
+			RegionCode:                ncloud.String(emulatorRegionCode),
+			HealthCheckProtocolType:   vloadbalancerCode(protocol),
+			HealthCheckPort:           req.TargetGroupPort,
+			HealthCheckUrlPath:        ncloud.String("/"),
+			HealthCheckHttpMethodType: vloadbalancerCode("GET"),
+			HealthCheckCycle:          ncloud.Int32(30),
+			HealthCheckUpThreshold:    ncloud.Int32(2),
+			HealthCheckDownThreshold:  ncloud.Int32(2),
+			TargetNoList:              req.TargetNoList,
+		}
+		if tg.TargetGroupName == nil {
+			tg.TargetGroupName = ncloud.String("tg-" + *tg.TargetGroupNo)
+		}
+		if tg.TargetGroupPort == nil {
+			tg.TargetGroupPort = ncloud.Int32(80)
+			tg.HealthCheckPort = ncloud.Int32(80)
+		}
+		if protocol == "TCP" || protocol == "PROXY_TCP" {
+			tg.HealthCheckProtocolType = vloadbalancerCode("TCP")
+		}
+		setEmulatorTargetGroupHealthCheck(tg, req.HealthCheckProtocolTypeCode, req.HealthCheckPort, req.HealthCheckUrlPath, req.HealthCheckHttpMethodTypeCode, req.HealthCheckCycle, req.HealthCheckUpThreshold, req.HealthCheckDownThreshold)
+
+		e.put("targetGroup", *tg.TargetGroupNo, tg)
+
+		return &vloadbalancer.CreateTargetGroupResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), TargetGroupList: []*vloadbalancer.TargetGroup{tg}}, nil
+	})
+
+	e.handle("vloadbalancer", "getTargetGroupList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vloadbalancer.GetTargetGroupListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("targetGroup", func(o interface{}) bool {
+			tg := o.(*vloadbalancer.TargetGroup)
+			return formMatchList(form, "targetGroupNoList", tg.TargetGroupNo) &&
+				formMatch(form, "vpcNo", tg.VpcNo) &&
+				formMatch(form, "targetTypeCode", tg.TargetType.Code)
+		}) {
+			resp.TargetGroupList = append(resp.TargetGroupList, o.(*vloadbalancer.TargetGroup))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.TargetGroupList)))
+		start, end := formPage(form, len(resp.TargetGroupList))
+		resp.TargetGroupList = resp.TargetGroupList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vloadbalancer", "changeTargetGroupHealthCheckConfiguration", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest{}
+		decodeEmulatorForm(form, req)
+
+		tg, ok := e.peek("targetGroup", ncloud.StringValue(req.TargetGroupNo)).(*vloadbalancer.TargetGroup)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "Target group [%s] not found", ncloud.StringValue(req.TargetGroupNo))
+		}
+		setEmulatorTargetGroupHealthCheck(tg, nil, req.HealthCheckPort, req.HealthCheckUrlPath, req.HealthCheckHttpMethodTypeCode, req.HealthCheckCycle, req.HealthCheckUpThreshold, req.HealthCheckDownThreshold)
+
+		return &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), TargetGroupList: []*vloadbalancer.TargetGroup{tg}}, nil
+	})
+
+	e.handle("vloadbalancer", "changeTargetGroupConfiguration", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vloadbalancer.ChangeTargetGroupConfigurationRequest{}
+		decodeEmulatorForm(form, req)
+
+		tg, ok := e.peek("targetGroup", ncloud.StringValue(req.TargetGroupNo)).(*vloadbalancer.TargetGroup)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "Target group [%s] not found", ncloud.StringValue(req.TargetGroupNo))
+		}
+		if req.AlgorithmTypeCode != nil {
+			tg.AlgorithmType = vloadbalancerCode(*req.AlgorithmTypeCode)
+		}
+		if req.UseStickySession != nil {
+			tg.UseStickySession = req.UseStickySession
+		}
+		if req.UseProxyProtocol != nil {
+			tg.UseProxyProtocol = req.UseProxyProtocol
+		}
+
+		return &vloadbalancer.ChangeTargetGroupConfigurationResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), TargetGroupList: []*vloadbalancer.TargetGroup{tg}}, nil
+	})
+
+	e.handle("vloadbalancer", "deleteTargetGroups", func(e *testEmulator, form url.Values) (interface{}, error) {
+		for _, no := range formList(form, "targetGroupNoList") {
+			tg, ok := e.peek("targetGroup", no).(*vloadbalancer.TargetGroup)
+			if !ok {
+				return nil, newEmulatorError(emulatorErrNotFound, "Target group [%s] not found", no)
+			}
+			if tg.LoadBalancerInstanceNo != nil {
+				return nil, newEmulatorError("1200014", "Target group [%s] is in use by load balancer [%s]", no, *tg.LoadBalancerInstanceNo)
+			}
+			e.remove("targetGroup", no)
+		}
+		return &vloadbalancer.DeleteTargetGroupsResponse{ReturnCode: ncloud.String("0")}, nil
+	})
+}
+
+func setEmulatorTargetGroupHealthCheck(tg *vloadbalancer.TargetGroup, protocol *string, port *int32, urlPath *string, httpMethod *string, cycle *int32, upThreshold *int32, downThreshold *int32) {
+	if protocol != nil {
+		tg.HealthCheckProtocolType = vloadbalancerCode(*protocol)
+	}
+	if port != nil {
+		tg.HealthCheckPort = port
+	}
+	if urlPath != nil {
+		tg.HealthCheckUrlPath = urlPath
+	}
+	if httpMethod != nil {
+		tg.HealthCheckHttpMethodType = vloadbalancerCode(*httpMethod)
+	}
+	if cycle != nil {
+		tg.HealthCheckCycle = cycle
+	}
+	if upThreshold != nil {
+		tg.HealthCheckUpThreshold = upThreshold
+	}
+	if downThreshold != nil {
+		tg.HealthCheckDownThreshold = downThreshold
+	}
+}
+
+func registerEmulatorVautoscaling(e *testEmulator) {
+	e.handle("vautoscaling", "createLaunchConfiguration", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vautoscaling.CreateLaunchConfigurationRequest{}
+		decodeEmulatorForm(form, req)
+
+		if req.ServerImageProductCode == nil && req.MemberServerImageInstanceNo == nil {
+			return nil, newEmulatorError(emulatorErrInvalid, "serverImageProductCode or memberServerImageInstanceNo is required")
+		}
+
+		l := &vautoscaling.LaunchConfiguration{
+			RegionCode:                  ncloud.String(emulatorRegionCode),
+			LaunchConfigurationNo:       ncloud.String(e.nextNo()),
+			LaunchConfigurationName:     req.LaunchConfigurationName,
+			ServerImageProductCode:      req.ServerImageProductCode,
+			MemberServerImageInstanceNo: req.MemberServerImageInstanceNo,
+			ServerProductCode:           req.ServerProductCode,
+			LoginKeyName:                req.LoginKeyName,
+			CreateDate:                  emulatorNow(),
+			LaunchConfigurationStatus:   &vautoscaling.CommonCode{Code: ncloud.String("CREAT"), CodeName: ncloud.String("CREAT")},
+			InitScriptNo:                req.InitScriptNo,
+			IsEncryptedVolume:           ncloud.Bool(ncloud.BoolValue(req.IsEncryptedVolume)),
+		}
+		if l.LaunchConfigurationName == nil {
+			l.LaunchConfigurationName = ncloud.String("lc-" + *l.LaunchConfigurationNo)
+		}
+		e.put("launchConfiguration", *l.LaunchConfigurationNo, l)
+
+		return &vautoscaling.CreateLaunchConfigurationResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), LaunchConfigurationList: []*vautoscaling.LaunchConfiguration{l}}, nil
+	})
+
+	e.handle("vautoscaling", "getLaunchConfigurationList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vautoscaling.GetLaunchConfigurationListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("launchConfiguration", func(o interface{}) bool {
+			l := o.(*vautoscaling.LaunchConfiguration)
+			return formMatchList(form, "launchConfigurationNoList", l.LaunchConfigurationNo) &&
+				formMatchList(form, "launchConfigurationNameList", l.LaunchConfigurationName)
+		}) {
+			resp.LaunchConfigurationList = append(resp.LaunchConfigurationList, o.(*vautoscaling.LaunchConfiguration))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.LaunchConfigurationList)))
+		start, end := formPage(form, len(resp.LaunchConfigurationList))
+		resp.LaunchConfigurationList = resp.LaunchConfigurationList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vautoscaling", "deleteLaunchConfiguration", func(e *testEmulator, form url.Values) (interface{}, error) {
+		no := form.Get("launchConfigurationNo")
+		if e.peek("launchConfiguration", no) == nil {
+			return nil, newEmulatorError(emulatorErrNotFound, "Launch configuration [%s] not found", no)
+		}
+		e.remove("launchConfiguration", no)
+
+		return &vautoscaling.DeleteLaunchConfigurationResponse{ReturnCode: ncloud.String("0")}, nil
+	})
+}
+
+// serveNKS serves the REST API of NKS. e.g. GET /vnks/v2/clusters/{uuid}
+func (e *testEmulator) serveNKS(w http.ResponseWriter, r *http.Request, path string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	key := "vnks/" + r.Method + " " + path
+	e.calls = append(e.calls, key)
+
+	if faults := e.faults[key]; len(faults) > 0 {
+		e.faults[key] = faults[1:]
+		writeEmulatorError(w, faults[0])
+		return
+	}
+
+	writeJSON := func(v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	switch {
+	case r.Method == http.MethodPost && path == "clusters":
+		body, _ := ioutil.ReadAll(r.Body)
+		req := &vnks.ClusterInputBody{}
+		if err := json.Unmarshal(body, req); err != nil {
+			writeEmulatorError(w, newEmulatorError(emulatorErrInvalid, "invalid request body: %s", err))
+			return
+		}
+
+		if err := e.validateEmulatorNKSCluster(req); err != nil {
+			writeEmulatorError(w, err)
+			return
+		}
+
+		uuid := fmt.Sprintf("%08s-0000-4000-8000-%012s", e.nextNo(), "0")
+		c := &vnks.Cluster{
+			Uuid:              ncloud.String(uuid),
+			AcgName:           ncloud.String("nks-" + uuid[:8]),
+			Name:              req.Name,
+			ClusterType:       req.ClusterType,
+			NodeCount:         ncloud.Int32(0),
+			NodeMaxCount:      ncloud.Int32(10),
+			CreatedAt:         emulatorNow(),
+			Endpoint:          ncloud.String(fmt.Sprintf("https://%s.kubernetes.ncloud.com", uuid)),
+			K8sVersion:        req.K8sVersion,
+			RegionCode:        req.RegionCode,
+			Status:            ncloud.String(NKSStatusCreatingCode),
+			KubeNetworkPlugin: req.KubeNetworkPlugin,
+			SubnetLbNo:        req.SubnetLbNo,
+			LbPublicSubnetNo:  req.LbPublicSubnetNo,
+			SubnetNoList:      req.SubnetNoList,
+			VpcNo:             req.VpcNo,
+			ZoneCode:          req.ZoneCode,
+			LoginKeyName:      req.LoginKeyName,
+			Log:               req.Log,
+		}
+		e.put("nksCluster", uuid, c, func() { c.Status = ncloud.String(NKSStatusNoNodeCode) })
+
+		writeJSON(&vnks.CreateClusterRes{Uuid: c.Uuid})
+
+	case r.Method == http.MethodGet && path == "clusters":
+		resp := &vnks.ClustersRes{Clusters: []*vnks.Cluster{}}
+		for _, o := range e.list("nksCluster", nil) {
+			resp.Clusters = append(resp.Clusters, o.(*vnks.Cluster))
+		}
+		writeJSON(resp)
+
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "clusters/"):
+		c, ok := e.get("nksCluster", strings.TrimPrefix(path, "clusters/")).(*vnks.Cluster)
+		if !ok {
+			writeEmulatorError(w, &emulatorError{status: http.StatusNotFound, returnCode: "404", returnMessage: "Cluster not found"})
+			return
+		}
+		writeJSON(&vnks.ClusterRes{Cluster: c})
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(path, "clusters/"):
+		uuid := strings.TrimPrefix(path, "clusters/")
+		c, ok := e.peek("nksCluster", uuid).(*vnks.Cluster)
+		if !ok {
+			writeEmulatorError(w, &emulatorError{status: http.StatusNotFound, returnCode: "404", returnMessage: "Cluster not found"})
+			return
+		}
+
+		c.Status = ncloud.String(NKSStatusDeletingCode)
+		e.transition("nksCluster", uuid, e.removeStep("nksCluster", uuid))
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		e.t.Errorf("emulator: unsupported operation %s", key)
+		writeEmulatorError(w, &emulatorError{status: http.StatusNotImplemented, returnCode: "300", returnMessage: "Not supported by emulator: " + key})
+	}
+}
+
+func (e *testEmulator) validateEmulatorNKSCluster(req *vnks.ClusterInputBody) *emulatorError {
+	vpcNo := fmt.Sprint(ncloud.Int32Value(req.VpcNo))
+	if _, ok := e.peek("vpc", vpcNo).(*vpc.Vpc); !ok {
+		return &emulatorError{status: http.StatusBadRequest, returnCode: "400", returnMessage: "VPC not found: " + vpcNo}
+	}
+
+	subnetNoList := append([]*int32{req.SubnetLbNo}, req.SubnetNoList...)
+	for _, no := range subnetNoList {
+		s, ok := e.peek("subnet", fmt.Sprint(ncloud.Int32Value(no))).(*vpc.Subnet)
+		if !ok || *s.VpcNo != vpcNo {
+			return &emulatorError{status: http.StatusBadRequest, returnCode: "400", returnMessage: fmt.Sprintf("Subnet not found in VPC %s: %d", vpcNo, ncloud.Int32Value(no))}
+		}
+	}
+
+	if e.peek("loginKey", ncloud.StringValue(req.LoginKeyName)) == nil {
+		return &emulatorError{status: http.StatusBadRequest, returnCode: "400", returnMessage: "Login key not found: " + ncloud.StringValue(req.LoginKeyName)}
+	}
+
+	return nil
+}
@@ -0,0 +1,572 @@
+package ncloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testEmulator is an in-memory fake of the NCP APIs, so that resource CRUD, waiters and import can be tested without an NCP account.
+// Every service is served by a single httptest server under the same path as the API gateway. (e.g. /vserver/v2/getServerInstanceList)
+// Objects go through the status transitions one step for each read, as the real API changes the status in the background.
+type testEmulator struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu       sync.Mutex
+	seq      int
+	objects  map[string]*emulatorObject
+	handlers map[string]emulatorHandler
+	faults   map[string][]*emulatorError
+	calls    []string
+	// forms is the request parameters of the last call of each operation
+	forms map[string]url.Values
+}
+
+// emulatorHandler handles a form API call. It returns the response body of the operation or an error.
+type emulatorHandler func(e *testEmulator, form url.Values) (interface{}, error)
+
+type emulatorObject struct {
+	kind  string
+	no    string
+	value interface{}
+	// steps are applied one by one on each read, e.g. INIT -> CREAT -> RUN
+	steps []func()
+}
+
+// emulatorError is returned in the same form as the API error response. e.g. {"responseError": {"returnCode": "25013", ...}}
+type emulatorError struct {
+	status        int
+	returnCode    string
+	returnMessage string
+}
+
+func (err *emulatorError) Error() string {
+	return fmt.Sprintf("[%s] %s", err.returnCode, err.returnMessage)
+}
+
+func newEmulatorError(returnCode, format string, a ...interface{}) *emulatorError {
+	return &emulatorError{
+		status:        http.StatusBadRequest,
+		returnCode:    returnCode,
+		returnMessage: fmt.Sprintf(format, a...),
+	}
+}
+
+const (
+	emulatorRegionCode  = "KR"
+	emulatorRegionNo    = "1"
+	emulatorAccessKey   = "emulator-access-key"
+	emulatorSecretKey   = "emulator-secret-key"
+	emulatorErrNotFound = "1000003"
+	emulatorErrInvalid  = "1000001"
+)
+
+// emulatorServiceRegistrations registers the handlers of each service
+var emulatorServiceRegistrations []func(e *testEmulator)
+
+func init() {
+	// The emulator changes the status on each read, so the waiters poll it without the delays for the real API
+	if os.Getenv(resource.TestEnvVar) == "" {
+		waiterDelay, waiterMinTimeout, retryDelay = 0, 0, 0
+	}
+}
+
+func newTestEmulator(t *testing.T) *testEmulator {
+	e := &testEmulator{
+		t:        t,
+		seq:      1000000,
+		objects:  map[string]*emulatorObject{},
+		handlers: map[string]emulatorHandler{},
+		faults:   map[string][]*emulatorError{},
+		forms:    map[string]url.Values{},
+	}
+
+	for _, register := range emulatorServiceRegistrations {
+		register(e)
+	}
+
+	e.server = httptest.NewServer(e)
+	t.Cleanup(e.server.Close)
+
+	return e
+}
+
+// handle registers the handler of the operation. e.g. e.handle("vserver", "getServerInstanceList", ...)
+func (e *testEmulator) handle(service, operation string, handler emulatorHandler) {
+	e.handlers[service+"/"+operation] = handler
+}
+
+// endpoints returns the `endpoints` block of the provider pointing to the emulator
+func (e *testEmulator) endpoints() map[string]interface{} {
+	endpoints := map[string]interface{}{}
+	for _, service := range endpointServiceNames {
+		endpoints[service] = fmt.Sprintf("%s/%s/v2", e.server.URL, service)
+	}
+
+	return endpoints
+}
+
+// failNext makes the next `times` calls of the operation fail with the return code
+func (e *testEmulator) failNext(service, operation string, times int, status int, returnCode string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i := 0; i < times; i++ {
+		e.faults[service+"/"+operation] = append(e.faults[service+"/"+operation], &emulatorError{
+			status:        status,
+			returnCode:    returnCode,
+			returnMessage: "injected by emulator",
+		})
+	}
+}
+
+// callCount returns how many times the operation was called
+func (e *testEmulator) callCount(service, operation string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	count := 0
+	for _, c := range e.calls {
+		if c == service+"/"+operation {
+			count++
+		}
+	}
+
+	return count
+}
+
+// lastForm returns the request parameters of the last call of the operation
+func (e *testEmulator) lastForm(service, operation string) url.Values {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.forms[service+"/"+operation]
+}
+
+func (e *testEmulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("x-ncp-iam-access-key") != emulatorAccessKey || r.Header.Get("x-ncp-apigw-signature-v1") == "" || r.Header.Get("x-ncp-apigw-timestamp") == "" {
+		writeEmulatorError(w, &emulatorError{status: http.StatusUnauthorized, returnCode: "200", returnMessage: "Authentication Failed"})
+		return
+	}
+
+	// e.g. /vserver/v2/getServerInstanceList
+	s := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(s) != 3 {
+		writeEmulatorError(w, &emulatorError{status: http.StatusNotFound, returnCode: "300", returnMessage: "Not Found Exception"})
+		return
+	}
+	service, operation := s[0], s[2]
+
+	if service == "vnks" {
+		e.serveNKS(w, r, operation)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeEmulatorError(w, newEmulatorError(emulatorErrInvalid, "invalid request body: %s", err))
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	key := service + "/" + operation
+	e.calls = append(e.calls, key)
+	e.forms[key] = form
+
+	if faults := e.faults[key]; len(faults) > 0 {
+		e.faults[key] = faults[1:]
+		writeEmulatorError(w, faults[0])
+		return
+	}
+
+	handler, ok := e.handlers[key]
+	if !ok {
+		e.t.Errorf("emulator: unsupported operation %s", key)
+		writeEmulatorError(w, &emulatorError{status: http.StatusNotImplemented, returnCode: "300", returnMessage: "Not supported by emulator: " + key})
+		return
+	}
+
+	resp, err := handler(e, form)
+	if err != nil {
+		if apiErr, ok := err.(*emulatorError); ok {
+			writeEmulatorError(w, apiErr)
+			return
+		}
+		writeEmulatorError(w, &emulatorError{status: http.StatusInternalServerError, returnCode: "1300", returnMessage: err.Error()})
+		return
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		e.t.Errorf("emulator: marshal response of %s: %s", key, err)
+		return
+	}
+
+	// The SDK strips the outer object by the position of the second "{", so the space after the key is required
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"%sResponse": %s}`, operation, b)
+}
+
+func writeEmulatorError(w http.ResponseWriter, err *emulatorError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.status)
+	b, _ := json.Marshal(map[string]interface{}{
+		"responseError": map[string]string{
+			"returnCode":    err.returnCode,
+			"returnMessage": err.returnMessage,
+		},
+	})
+	w.Write(b)
+}
+
+// nextNo returns a new instance number
+func (e *testEmulator) nextNo() string {
+	e.seq++
+	return strconv.Itoa(e.seq)
+}
+
+func (e *testEmulator) put(kind, no string, value interface{}, steps ...func()) {
+	e.objects[kind+"/"+no] = &emulatorObject{kind: kind, no: no, value: value, steps: steps}
+}
+
+// transition appends the status changes to the object, which are applied on the following reads
+func (e *testEmulator) transition(kind, no string, steps ...func()) {
+	if o, ok := e.objects[kind+"/"+no]; ok {
+		o.steps = append(o.steps, steps...)
+	}
+}
+
+func (e *testEmulator) remove(kind, no string) {
+	delete(e.objects, kind+"/"+no)
+}
+
+// removeStep returns a step which deletes the object, the last step of termination
+func (e *testEmulator) removeStep(kind, no string) func() {
+	return func() { e.remove(kind, no) }
+}
+
+// peek returns the object without changing its status
+func (e *testEmulator) peek(kind, no string) interface{} {
+	if o, ok := e.objects[kind+"/"+no]; ok {
+		return o.value
+	}
+
+	return nil
+}
+
+// get reads the object, which applies the next status change
+func (e *testEmulator) get(kind, no string) interface{} {
+	o, ok := e.objects[kind+"/"+no]
+	if !ok {
+		return nil
+	}
+
+	if len(o.steps) > 0 {
+		step := o.steps[0]
+		o.steps = o.steps[1:]
+		step()
+	}
+
+	// The step may have removed the object
+	return e.peek(kind, no)
+}
+
+// list reads the objects of the kind in the order of creation which match the filter
+func (e *testEmulator) list(kind string, filter func(v interface{}) bool) []interface{} {
+	var nos []string
+	for _, o := range e.objects {
+		if o.kind == kind {
+			nos = append(nos, o.no)
+		}
+	}
+	sort.Strings(nos)
+
+	var result []interface{}
+	for _, no := range nos {
+		if v := e.get(kind, no); v != nil && (filter == nil || filter(v)) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// busy returns whether the object still has status changes in progress
+func (e *testEmulator) busy(kind, no string) bool {
+	o, ok := e.objects[kind+"/"+no]
+	return ok && len(o.steps) > 0
+}
+
+// formList returns the values of a list parameter. e.g. serverInstanceNoList.1, serverInstanceNoList.2
+func formList(form url.Values, name string) []string {
+	var keys []string
+	for k := range form {
+		if strings.HasPrefix(k, name+".") && !strings.Contains(strings.TrimPrefix(k, name+"."), ".") {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(keys[i], name+"."))
+		b, _ := strconv.Atoi(strings.TrimPrefix(keys[j], name+"."))
+		return a < b
+	})
+
+	var values []string
+	for _, k := range keys {
+		values = append(values, form.Get(k))
+	}
+
+	return values
+}
+
+// formMatch returns whether the value matches the optional filter parameter
+func formMatch(form url.Values, name string, value *string) bool {
+	v := form.Get(name)
+	return v == "" || (value != nil && *value == v)
+}
+
+// formMatchList returns whether the value is in the optional list parameter
+func formMatchList(form url.Values, name string, value *string) bool {
+	l := formList(form, name)
+	return len(l) == 0 || (value != nil && containsInStringList(*value, l))
+}
+
+// formPage returns the range of the rows of the page requested by pageNo and pageSize. All rows without pageSize
+func formPage(form url.Values, totalRows int) (int, int) {
+	pageSize, _ := strconv.Atoi(form.Get("pageSize"))
+	if pageSize <= 0 {
+		return 0, totalRows
+	}
+
+	pageNo, _ := strconv.Atoi(form.Get("pageNo"))
+	if pageNo < 1 {
+		pageNo = 1
+	}
+
+	start := (pageNo - 1) * pageSize
+	if start > totalRows {
+		start = totalRows
+	}
+	end := start + pageSize
+	if end > totalRows {
+		end = totalRows
+	}
+
+	return start, end
+}
+
+// decodeEmulatorForm sets the form parameters to the SDK request struct in the reverse way of the SDK encoding.
+// e.g. networkInterfaceList.1.accessControlGroupNoList.1=123
+func decodeEmulatorForm(form url.Values, req interface{}) {
+	for k, v := range form {
+		if len(v) > 0 {
+			setEmulatorField(reflect.ValueOf(req).Elem(), strings.Split(k, "."), v[0])
+		}
+	}
+}
+
+func setEmulatorField(v reflect.Value, path []string, value string) {
+	if v.Kind() != reflect.Struct || len(path) == 0 {
+		return
+	}
+
+	f := v.FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, path[0]) })
+	if !f.IsValid() {
+		return
+	}
+
+	switch f.Kind() {
+	case reflect.Ptr:
+		if f.IsNil() {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		if f.Type().Elem().Kind() == reflect.Struct {
+			setEmulatorField(f.Elem(), path[1:], value)
+		} else {
+			setEmulatorScalar(f.Elem(), value)
+		}
+	case reflect.Slice:
+		if len(path) < 2 {
+			return
+		}
+		index, err := strconv.Atoi(path[1])
+		if err != nil || index < 1 {
+			return
+		}
+		for f.Len() < index {
+			f.Set(reflect.Append(f, reflect.New(f.Type().Elem().Elem())))
+		}
+		item := f.Index(index - 1)
+		if item.Elem().Kind() == reflect.Struct {
+			setEmulatorField(item.Elem(), path[2:], value)
+		} else {
+			setEmulatorScalar(item.Elem(), value)
+		}
+	}
+}
+
+func setEmulatorScalar(v reflect.Value, value string) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, _ := strconv.ParseBool(value)
+		v.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		i, _ := strconv.ParseInt(value, 10, 64)
+		v.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		f, _ := strconv.ParseFloat(value, 64)
+		v.SetFloat(f)
+	}
+}
+
+func emulatorNow() *string {
+	s := time.Now().Format("2006-01-02T15:04:05-0700")
+	return &s
+}
+
+// testEmulatorProvider configures the provider against the emulator through the `endpoints` block, in the same way as users do
+func testEmulatorProvider(t *testing.T, e *testEmulator, supportVPC bool) (*schema.Provider, *ProviderConfig) {
+	return testEmulatorProviderWithTransport(t, e, supportVPC, http.DefaultTransport)
+}
+
+func testEmulatorProviderWithTransport(t *testing.T, e *testEmulator, supportVPC bool, transport http.RoundTripper) (*schema.Provider, *ProviderConfig) {
+	return testEmulatorProviderWithConfig(t, e, supportVPC, transport, nil)
+}
+
+// testEmulatorProviderWithConfig configures the provider against the emulator with the extra provider arguments
+func testEmulatorProviderWithConfig(t *testing.T, e *testEmulator, supportVPC bool, transport http.RoundTripper, extra map[string]interface{}) (*schema.Provider, *ProviderConfig) {
+	p := Provider()
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return configureProvider(d, transport)
+	}
+
+	raw := map[string]interface{}{
+		"access_key":        emulatorAccessKey,
+		"secret_key":        emulatorSecretKey,
+		"region":            emulatorRegionCode,
+		"support_vpc":       supportVPC,
+		"retry_max_backoff": "10ms",
+		"endpoints":         []interface{}{e.endpoints()},
+	}
+	for k, v := range extra {
+		raw[k] = v
+	}
+
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("error configuring provider with emulator: %v", diags)
+	}
+
+	return p, p.Meta().(*ProviderConfig)
+}
+
+// testEmulatorApply plans and applies the configuration of the resource in the same way as terraform does
+func testEmulatorApply(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("error planning: %s", err)
+	}
+
+	if diff == nil {
+		return state
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("error applying: %v", diags)
+	}
+
+	return newState
+}
+
+// testEmulatorPlan returns the planned diff of the configuration
+func testEmulatorPlan(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) *terraform.InstanceDiff {
+	t.Helper()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("error planning: %s", err)
+	}
+
+	return diff
+}
+
+func testEmulatorRefresh(t *testing.T, r *schema.Resource, state *terraform.InstanceState, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+
+	newState, diags := r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("error refreshing: %v", diags)
+	}
+
+	return newState
+}
+
+// testEmulatorImport imports the resource by ID and reads it
+func testEmulatorImport(t *testing.T, r *schema.Resource, id string, meta interface{}) *terraform.InstanceState {
+	t.Helper()
+
+	d := r.Data(&terraform.InstanceState{ID: id})
+	var imported []*schema.ResourceData
+	var err error
+
+	switch {
+	case r.Importer.StateContext != nil:
+		imported, err = r.Importer.StateContext(context.Background(), d, meta)
+	case r.Importer.State != nil:
+		imported, err = r.Importer.State(d, meta)
+	}
+
+	if err != nil || len(imported) != 1 {
+		t.Fatalf("error importing %s: %v", id, err)
+	}
+
+	return testEmulatorRefresh(t, r, imported[0].State(), meta)
+}
+
+func testEmulatorDestroy(t *testing.T, r *schema.Resource, state *terraform.InstanceState, meta interface{}) {
+	t.Helper()
+
+	if _, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("error destroying: %v", diags)
+	}
+}
+
+// testCheckEmulatorState checks the attributes of the state
+func testCheckEmulatorState(t *testing.T, state *terraform.InstanceState, expected map[string]string) {
+	t.Helper()
+
+	if state == nil || state.ID == "" {
+		t.Fatalf("Expected resource to exist")
+	}
+
+	for k, v := range expected {
+		if state.Attributes[k] != v {
+			t.Errorf("Expected %s to be %q, got %q", k, v, state.Attributes[k])
+		}
+	}
+}
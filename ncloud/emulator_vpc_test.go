@@ -0,0 +1,302 @@
+package ncloud
+
+import (
+	"net/url"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func init() {
+	emulatorServiceRegistrations = append(emulatorServiceRegistrations, registerEmulatorVpc)
+}
+
+func vpcCode(code string) *vpc.CommonCode {
+	return &vpc.CommonCode{Code: ncloud.String(code), CodeName: ncloud.String(code)}
+}
+
+// vpcStatusSteps returns the status changes of VPC resources after creation, INIT -> CREATING -> RUN
+func vpcStatusSteps(status **vpc.CommonCode) []func() {
+	return []func(){
+		func() { *status = vpcCode("CREATING") },
+		func() { *status = vpcCode("RUN") },
+	}
+}
+
+func registerEmulatorVpc(e *testEmulator) {
+	e.handle("vpc", "createVpc", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vpc.CreateVpcRequest{}
+		decodeEmulatorForm(form, req)
+
+		if req.Ipv4CidrBlock == nil {
+			return nil, newEmulatorError(emulatorErrInvalid, "ipv4CidrBlock is required")
+		}
+
+		v := &vpc.Vpc{
+			VpcNo:         ncloud.String(e.nextNo()),
+			VpcName:       req.VpcName,
+			Ipv4CidrBlock: req.Ipv4CidrBlock,
+			VpcStatus:     vpcCode("INIT"),
+			RegionCode:    ncloud.String(emulatorRegionCode),
+			CreateDate:    emulatorNow(),
+		}
+		if v.VpcName == nil {
+			v.VpcName = ncloud.String("vpc-" + *v.VpcNo)
+		}
+		e.put("vpc", *v.VpcNo, v, vpcStatusSteps(&v.VpcStatus)...)
+
+		// The default network ACL, ACG and route tables are created with the VPC
+		e.createEmulatorDefaultVpcObjects(v)
+
+		return &vpc.CreateVpcResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), VpcList: []*vpc.Vpc{v}}, nil
+	})
+
+	e.handle("vpc", "getVpcDetail", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetVpcDetailResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(0)}
+		if v, ok := e.get("vpc", form.Get("vpcNo")).(*vpc.Vpc); ok {
+			resp.VpcList = []*vpc.Vpc{v}
+			resp.TotalRows = ncloud.Int32(1)
+		}
+		return resp, nil
+	})
+
+	e.handle("vpc", "getVpcList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetVpcListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("vpc", func(o interface{}) bool {
+			v := o.(*vpc.Vpc)
+			return formMatch(form, "vpcName", v.VpcName) && formMatchList(form, "vpcNoList", v.VpcNo)
+		}) {
+			resp.VpcList = append(resp.VpcList, o.(*vpc.Vpc))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.VpcList)))
+		start, end := formPage(form, len(resp.VpcList))
+		resp.VpcList = resp.VpcList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vpc", "deleteVpc", func(e *testEmulator, form url.Values) (interface{}, error) {
+		no := form.Get("vpcNo")
+		v, ok := e.peek("vpc", no).(*vpc.Vpc)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "VPC [%s] not found", no)
+		}
+
+		for _, o := range e.objects {
+			switch v := o.value.(type) {
+			case *vpc.Subnet:
+				if *v.VpcNo == no {
+					return nil, newEmulatorError("1000036", "VPC [%s] has subnet [%s]", no, *v.SubnetNo)
+				}
+			case *vserver.AccessControlGroup:
+				if *v.VpcNo == no && !*v.IsDefault {
+					return nil, newEmulatorError("1000036", "VPC [%s] has access control group [%s]", no, *v.AccessControlGroupNo)
+				}
+			}
+		}
+
+		v.VpcStatus = vpcCode("TERMTING")
+		e.transition("vpc", no, e.removeStep("vpc", no))
+		e.removeEmulatorDefaultVpcObjects(no)
+
+		return &vpc.DeleteVpcResponse{ReturnCode: ncloud.String("0"), VpcList: []*vpc.Vpc{v}}, nil
+	})
+
+	e.handle("vpc", "getNetworkAclList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetNetworkAclListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("networkAcl", func(o interface{}) bool {
+			return formMatch(form, "vpcNo", o.(*vpc.NetworkAcl).VpcNo)
+		}) {
+			resp.NetworkAclList = append(resp.NetworkAclList, o.(*vpc.NetworkAcl))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.NetworkAclList)))
+		start, end := formPage(form, len(resp.NetworkAclList))
+		resp.NetworkAclList = resp.NetworkAclList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vpc", "getNetworkAclDetail", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetNetworkAclDetailResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(0)}
+		if v, ok := e.get("networkAcl", form.Get("networkAclNo")).(*vpc.NetworkAcl); ok {
+			resp.NetworkAclList = []*vpc.NetworkAcl{v}
+			resp.TotalRows = ncloud.Int32(1)
+		}
+		return resp, nil
+	})
+
+	e.handle("vpc", "getRouteTableList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetRouteTableListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("routeTable", func(o interface{}) bool {
+			return formMatch(form, "vpcNo", o.(*vpc.RouteTable).VpcNo)
+		}) {
+			resp.RouteTableList = append(resp.RouteTableList, o.(*vpc.RouteTable))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.RouteTableList)))
+		start, end := formPage(form, len(resp.RouteTableList))
+		resp.RouteTableList = resp.RouteTableList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vpc", "createSubnet", func(e *testEmulator, form url.Values) (interface{}, error) {
+		req := &vpc.CreateSubnetRequest{}
+		decodeEmulatorForm(form, req)
+
+		v, ok := e.peek("vpc", ncloud.StringValue(req.VpcNo)).(*vpc.Vpc)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "VPC [%s] not found", ncloud.StringValue(req.VpcNo))
+		}
+
+		if *v.VpcStatus.Code != "RUN" {
+			return nil, newEmulatorError(SubnetPleaseTryAgainErrorCode, "VPC [%s] is not running", *v.VpcNo)
+		}
+
+		s := &vpc.Subnet{
+			SubnetNo:     ncloud.String(e.nextNo()),
+			VpcNo:        req.VpcNo,
+			ZoneCode:     req.ZoneCode,
+			SubnetName:   req.SubnetName,
+			Subnet:       req.Subnet,
+			SubnetStatus: vpcCode("INIT"),
+			CreateDate:   emulatorNow(),
+			SubnetType:   vpcCode(ncloud.StringValue(req.SubnetTypeCode)),
+			UsageType:    vpcCode("GEN"),
+			NetworkAclNo: req.NetworkAclNo,
+		}
+		if ncloud.StringValue(req.UsageTypeCode) != "" {
+			s.UsageType = vpcCode(*req.UsageTypeCode)
+		}
+		e.put("subnet", *s.SubnetNo, s, vpcStatusSteps(&s.SubnetStatus)...)
+
+		return &vpc.CreateSubnetResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(1), SubnetList: []*vpc.Subnet{s}}, nil
+	})
+
+	e.handle("vpc", "getSubnetDetail", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetSubnetDetailResponse{ReturnCode: ncloud.String("0"), TotalRows: ncloud.Int32(0)}
+		if s, ok := e.get("subnet", form.Get("subnetNo")).(*vpc.Subnet); ok {
+			resp.SubnetList = []*vpc.Subnet{s}
+			resp.TotalRows = ncloud.Int32(1)
+		}
+		return resp, nil
+	})
+
+	e.handle("vpc", "getSubnetList", func(e *testEmulator, form url.Values) (interface{}, error) {
+		resp := &vpc.GetSubnetListResponse{ReturnCode: ncloud.String("0")}
+		for _, o := range e.list("subnet", func(o interface{}) bool {
+			s := o.(*vpc.Subnet)
+			return formMatch(form, "vpcNo", s.VpcNo) && formMatchList(form, "subnetNoList", s.SubnetNo)
+		}) {
+			resp.SubnetList = append(resp.SubnetList, o.(*vpc.Subnet))
+		}
+		resp.TotalRows = ncloud.Int32(int32(len(resp.SubnetList)))
+		start, end := formPage(form, len(resp.SubnetList))
+		resp.SubnetList = resp.SubnetList[start:end]
+		return resp, nil
+	})
+
+	e.handle("vpc", "deleteSubnet", func(e *testEmulator, form url.Values) (interface{}, error) {
+		no := form.Get("subnetNo")
+		s, ok := e.peek("subnet", no).(*vpc.Subnet)
+		if !ok {
+			return nil, newEmulatorError(emulatorErrNotFound, "Subnet [%s] not found", no)
+		}
+
+		if e.busy("subnet", no) {
+			return nil, newEmulatorError(ApiErrorObjectInOperation, "Subnet [%s] is in operation", no)
+		}
+
+		s.SubnetStatus = vpcCode("TERMTING")
+		e.transition("subnet", no, e.removeStep("subnet", no))
+
+		return &vpc.DeleteSubnetResponse{ReturnCode: ncloud.String("0"), SubnetList: []*vpc.Subnet{s}}, nil
+	})
+}
+
+func (e *testEmulator) createEmulatorDefaultVpcObjects(v *vpc.Vpc) {
+	acl := &vpc.NetworkAcl{
+		NetworkAclNo:     ncloud.String(e.nextNo()),
+		NetworkAclName:   ncloud.String(*v.VpcName + "-default-network-acl"),
+		VpcNo:            v.VpcNo,
+		NetworkAclStatus: vpcCode("RUN"),
+		IsDefault:        ncloud.Bool(true),
+	}
+	e.put("networkAcl", *acl.NetworkAclNo, acl)
+
+	for _, subnetType := range []string{"PUBLIC", "PRIVATE"} {
+		rt := &vpc.RouteTable{
+			RouteTableNo:        ncloud.String(e.nextNo()),
+			RouteTableName:      ncloud.String(*v.VpcName + "-default-" + subnetType),
+			RegionCode:          ncloud.String(emulatorRegionCode),
+			VpcNo:               v.VpcNo,
+			SupportedSubnetType: vpcCode(subnetType),
+			IsDefault:           ncloud.Bool(true),
+			RouteTableStatus:    vpcCode("RUN"),
+		}
+		e.put("routeTable", *rt.RouteTableNo, rt)
+	}
+
+	e.createEmulatorAccessControlGroup(v.VpcNo, ncloud.String(*v.VpcName+"-default-acg"), true)
+}
+
+func (e *testEmulator) removeEmulatorDefaultVpcObjects(vpcNo string) {
+	for _, o := range e.objects {
+		switch v := o.value.(type) {
+		case *vpc.NetworkAcl:
+			if *v.VpcNo == vpcNo {
+				e.remove(o.kind, o.no)
+			}
+		case *vpc.RouteTable:
+			if *v.VpcNo == vpcNo {
+				e.remove(o.kind, o.no)
+			}
+		case *vserver.AccessControlGroup:
+			if *v.VpcNo == vpcNo {
+				e.remove(o.kind, o.no)
+			}
+		}
+	}
+}
+
+// seedVpc creates a running VPC with a public subnet in KR-1 without going through the status transitions
+func (e *testEmulator) seedVpc(cidr, subnet string) (vpcNo, subnetNo string) {
+	e.mu.Lock()
+	v := &vpc.Vpc{
+		VpcNo:         ncloud.String(e.nextNo()),
+		Ipv4CidrBlock: ncloud.String(cidr),
+		VpcStatus:     vpcCode("RUN"),
+		RegionCode:    ncloud.String(emulatorRegionCode),
+		CreateDate:    emulatorNow(),
+	}
+	v.VpcName = ncloud.String("vpc-" + *v.VpcNo)
+	e.put("vpc", *v.VpcNo, v)
+	e.createEmulatorDefaultVpcObjects(v)
+	e.mu.Unlock()
+
+	return *v.VpcNo, e.seedSubnet(*v.VpcNo, subnet, "PUBLIC", "GEN")
+}
+
+// seedSubnet creates a running subnet in KR-1 without going through the status transitions
+func (e *testEmulator) seedSubnet(vpcNo, subnet, subnetType, usageType string) string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s := &vpc.Subnet{
+		SubnetNo:     ncloud.String(e.nextNo()),
+		VpcNo:        ncloud.String(vpcNo),
+		ZoneCode:     ncloud.String(emulatorZones[0]),
+		Subnet:       ncloud.String(subnet),
+		SubnetStatus: vpcCode("RUN"),
+		CreateDate:   emulatorNow(),
+		SubnetType:   vpcCode(subnetType),
+		UsageType:    vpcCode(usageType),
+	}
+	s.SubnetName = ncloud.String("subnet-" + *s.SubnetNo)
+	for _, o := range e.list("networkAcl", func(o interface{}) bool {
+		acl := o.(*vpc.NetworkAcl)
+		return *acl.VpcNo == vpcNo && *acl.IsDefault
+	}) {
+		s.NetworkAclNo = o.(*vpc.NetworkAcl).NetworkAclNo
+	}
+	e.put("subnet", *s.SubnetNo, s)
+
+	return *s.SubnetNo
+}
@@ -14,10 +14,30 @@ func NotSupportVpc(name string) error {
 
 //ErrorRequiredArgOnVpc return error for required on vpc
 func ErrorRequiredArgOnVpc(name string) error {
-	return fmt.Errorf("missing required argument: The argument \"%s\" is required on vpc", name)
+	return &attributeError{
+		Attribute: name,
+		Err:       fmt.Errorf("missing required argument: The argument \"%s\" is required on vpc", name),
+	}
 }
 
 //ErrorRequiredArgOnClassic return error for required on classic
 func ErrorRequiredArgOnClassic(name string) error {
-	return fmt.Errorf("missing required argument: The argument \"%s\" is required on classic", name)
+	return &attributeError{
+		Attribute: name,
+		Err:       fmt.Errorf("missing required argument: The argument \"%s\" is required on classic", name),
+	}
+}
+
+// attributeError is an error caused by the value of an attribute. Its diagnostic points to the attribute.
+type attributeError struct {
+	Attribute string
+	Err       error
+}
+
+func (e *attributeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *attributeError) Unwrap() error {
+	return e.Err
 }
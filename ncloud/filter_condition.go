@@ -0,0 +1,302 @@
+package ncloud
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	filterOperatorEq       = "eq"
+	filterOperatorNe       = "ne"
+	filterOperatorGt       = "gt"
+	filterOperatorGte      = "gte"
+	filterOperatorLt       = "lt"
+	filterOperatorLte      = "lte"
+	filterOperatorPrefix   = "prefix"
+	filterOperatorContains = "contains"
+	filterOperatorInCidr   = "in_cidr"
+)
+
+var filterOperators = []string{
+	filterOperatorEq,
+	filterOperatorNe,
+	filterOperatorGt,
+	filterOperatorGte,
+	filterOperatorLt,
+	filterOperatorLte,
+	filterOperatorPrefix,
+	filterOperatorContains,
+	filterOperatorInCidr,
+}
+
+// filterQuantityPattern matches numbers with an optional size unit. e.g. 16, 2.5, 16GB, 500 MB
+var filterQuantityPattern = regexp.MustCompile(`(?i)^\s*(-?\d+(?:\.\d+)?)\s*([KMGT]B)?\s*$`)
+
+var filterQuantityUnits = map[string]float64{
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+	"TB": 1 << 40,
+}
+
+// filterCondition is a `filter` block of a data source, whose values are parsed once for all items
+type filterCondition struct {
+	name     string
+	operator string
+	matchAll bool
+	values   []filterValue
+}
+
+type filterValue struct {
+	raw   string
+	regex *regexp.Regexp
+	cidr  *net.IPNet
+}
+
+func newFilterCondition(f map[string]interface{}) (*filterCondition, error) {
+	c := &filterCondition{
+		name:     f["name"].(string),
+		operator: filterOperatorEq,
+	}
+	if v, ok := f["operator"].(string); ok && v != "" {
+		c.operator = v
+	}
+	if v, ok := f["match_all"].(bool); ok {
+		c.matchAll = v
+	}
+	isRegex, _ := f["regex"].(bool)
+
+	for _, raw := range f["values"].([]interface{}) {
+		s, _ := raw.(string)
+		v := filterValue{raw: s}
+
+		switch c.operator {
+		case filterOperatorEq, filterOperatorNe:
+			if isRegex {
+				re, err := regexp.Compile(v.raw)
+				if err != nil {
+					return nil, fmt.Errorf("invalid regular expression %q of %q filter: %s", v.raw, c.name, err)
+				}
+				v.regex = re
+			}
+		case filterOperatorGt, filterOperatorGte, filterOperatorLt, filterOperatorLte:
+			if _, ok := parseDate(v.raw); !ok {
+				if _, _, ok := parseFilterQuantity(v.raw); !ok {
+					return nil, fmt.Errorf("%q filter with %q operator needs a number or a date, got %q", c.name, c.operator, v.raw)
+				}
+			}
+		case filterOperatorInCidr:
+			_, cidr, err := net.ParseCIDR(v.raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR block %q of %q filter: %s", v.raw, c.name, err)
+			}
+			v.cidr = cidr
+		}
+
+		if isRegex && v.regex == nil {
+			return nil, fmt.Errorf("%q filter: regex is only supported with %q and %q operators", c.name, filterOperatorEq, filterOperatorNe)
+		}
+
+		c.values = append(c.values, v)
+	}
+
+	return c, nil
+}
+
+// match returns whether the target matches any of the values, or all of them with match_all.
+// `ne` matches the target which equals none of the values, regardless of match_all.
+func (c *filterCondition) match(target interface{}) bool {
+	if c.operator == filterOperatorNe {
+		for _, v := range c.values {
+			if c.matchAnyElement(target, filterOperatorEq, v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, v := range c.values {
+		if matched := c.matchAnyElement(target, c.operator, v); matched != c.matchAll {
+			return matched
+		}
+	}
+
+	return c.matchAll && len(c.values) > 0
+}
+
+// matchAnyElement matches the value with the target, or any element of the target if it is a list. e.g. tags
+func (c *filterCondition) matchAnyElement(target interface{}, operator string, v filterValue) bool {
+	val := reflect.ValueOf(target)
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return false
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			if c.matchAnyElement(val.Index(i).Interface(), operator, v) {
+				return true
+			}
+		}
+		return false
+	case reflect.Invalid:
+		return false
+	}
+
+	return matchFilterScalar(val, operator, v)
+}
+
+func matchFilterScalar(val reflect.Value, operator string, v filterValue) bool {
+	switch operator {
+	case filterOperatorEq:
+		return equalFilterScalar(val, v)
+	case filterOperatorGt, filterOperatorGte, filterOperatorLt, filterOperatorLte:
+		cmp, ok := compareFilterScalar(val, v.raw)
+		if !ok {
+			return false
+		}
+		switch operator {
+		case filterOperatorGt:
+			return cmp > 0
+		case filterOperatorGte:
+			return cmp >= 0
+		case filterOperatorLt:
+			return cmp < 0
+		default:
+			return cmp <= 0
+		}
+	case filterOperatorPrefix:
+		return strings.HasPrefix(filterScalarString(val), v.raw)
+	case filterOperatorContains:
+		return strings.Contains(filterScalarString(val), v.raw)
+	case filterOperatorInCidr:
+		return inFilterCidr(filterScalarString(val), v.cidr)
+	}
+
+	return false
+}
+
+func equalFilterScalar(val reflect.Value, v filterValue) bool {
+	switch val.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(v.raw)
+		return err == nil && val.Bool() == b
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// users can supply string or int like `values = [300, "3600"]` but terraform will converts to string
+		i, err := strconv.ParseInt(v.raw, 10, 64)
+		return err == nil && val.Int() == i
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(v.raw, 64)
+		return err == nil && val.Float() == f
+	case reflect.String:
+		if v.regex != nil {
+			return v.regex.MatchString(val.String())
+		}
+		return val.String() == v.raw
+	}
+
+	return false
+}
+
+// compareFilterScalar compares the target with the filter value as dates, or as numbers with optional size units
+func compareFilterScalar(val reflect.Value, raw string) (int, bool) {
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareFilterNumbers(float64(val.Int()), raw)
+	case reflect.Float32, reflect.Float64:
+		return compareFilterNumbers(val.Float(), raw)
+	case reflect.String:
+		return compareFilterStrings(val.String(), raw)
+	}
+
+	return 0, false
+}
+
+func compareFilterStrings(s string, raw string) (int, bool) {
+	if t, ok := parseDate(s); ok {
+		if ft, ok := parseDate(raw); ok {
+			return compareFilterFloats(float64(t.UnixNano()), float64(ft.UnixNano())), true
+		}
+		return 0, false
+	}
+
+	n, unit, ok := parseFilterQuantity(s)
+	if !ok {
+		return 0, false
+	}
+	fn, funit, ok := parseFilterQuantity(raw)
+	if !ok {
+		return 0, false
+	}
+	// Sizes are compared in bytes when both have units. Otherwise the numbers are compared as they are, e.g. 16 with 16GB
+	if unit != "" && funit != "" {
+		n, fn = n*filterQuantityUnits[unit], fn*filterQuantityUnits[funit]
+	}
+
+	return compareFilterFloats(n, fn), true
+}
+
+func compareFilterNumbers(n float64, raw string) (int, bool) {
+	fn, _, ok := parseFilterQuantity(raw)
+	if !ok {
+		return 0, false
+	}
+
+	return compareFilterFloats(n, fn), true
+}
+
+func compareFilterFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// parseFilterQuantity parses a number with an optional size unit. e.g. "16GB" -> 16, "GB"
+func parseFilterQuantity(s string) (float64, string, bool) {
+	m := filterQuantityPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, "", false
+	}
+
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return n, strings.ToUpper(m[2]), true
+}
+
+func filterScalarString(val reflect.Value) string {
+	if val.Kind() == reflect.String {
+		return val.String()
+	}
+
+	return fmt.Sprint(val.Interface())
+}
+
+// inFilterCidr returns whether the IP address or the CIDR block is within the CIDR block of the filter
+func inFilterCidr(s string, cidr *net.IPNet) bool {
+	if ip := net.ParseIP(s); ip != nil {
+		return cidr.Contains(ip)
+	}
+
+	ip, block, err := net.ParseCIDR(s)
+	if err != nil {
+		return false
+	}
+	filterOnes, _ := cidr.Mask.Size()
+	ones, _ := block.Mask.Size()
+
+	return cidr.Contains(ip) && ones >= filterOnes
+}
@@ -6,12 +6,11 @@ package ncloud
 import (
 	"fmt"
 	"log"
-	"reflect"
-	"regexp"
-	"strconv"
 	"strings"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceFiltersSchema() *schema.Schema {
@@ -37,14 +36,27 @@ func dataSourceFiltersSchema() *schema.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"operator": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          filterOperatorEq,
+					ValidateDiagFunc: ToDiagFunc(validation.StringInSlice(filterOperators, false)),
+				},
+
+				"match_all": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 			},
 		},
 	}
 }
 
-func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) []map[string]interface{} {
+func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) ([]map[string]interface{}, error) {
 	if filters == nil || filters.Len() == 0 {
-		return items
+		return items, nil
 	}
 
 	for _, f := range filters.List() {
@@ -57,39 +69,107 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 			pathElements = []string{keyword}
 		}
 
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
+		condition, err := newFilterCondition(fSet)
+		if err != nil {
+			return nil, err
 		}
 
 		// build a collection of items from matches against the set of filters
 		res := make([]map[string]interface{}, 0)
 		for _, item := range items {
 			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if targetValOk && condition.match(targetVal) {
 				res = append(res, item)
 			}
 		}
 		items = res
 	}
 
-	return items
+	return items, nil
+}
+
+// dataSourceFilters returns the `filter` blocks of the data source, or nil if it has none
+func dataSourceFilters(d *schema.ResourceData) *schema.Set {
+	filters, _ := d.Get("filter").(*schema.Set)
+	return filters
+}
+
+// filterRequestParams maps the filter names to the setters of the request parameters which the API filters by
+type filterRequestParams map[string]func(values []string) bool
+
+// pushDownFilters sets the request parameters of the filters which the API can apply instead,
+// and returns the rest of the filters to be applied by ApplyFilters.
+// Only `eq` filters without regex are pushed down, and a parameter already set by an argument is left as it is.
+func pushDownFilters(filters *schema.Set, params filterRequestParams) *schema.Set {
+	if filters == nil || filters.Len() == 0 {
+		return filters
+	}
+
+	remaining := schema.NewSet(filters.F, nil)
+	for _, f := range filters.List() {
+		fSet := f.(map[string]interface{})
+		name := fSet["name"].(string)
+
+		if set, ok := params[name]; ok && isPushableFilter(fSet) && set(filterValues(fSet)) {
+			log.Printf("[DEBUG] filter %q is applied by the request parameter", name)
+			continue
+		}
+		remaining.Add(f)
+	}
+
+	return remaining
+}
+
+func isPushableFilter(f map[string]interface{}) bool {
+	if operator, _ := f["operator"].(string); operator != "" && operator != filterOperatorEq {
+		return false
+	}
+	if regex, _ := f["regex"].(bool); regex {
+		return false
+	}
+
+	values := filterValues(f)
+	if len(values) == 0 {
+		return false
+	}
+	// A list parameter matches any of the values
+	if matchAll, _ := f["match_all"].(bool); matchAll && len(values) > 1 {
+		return false
+	}
+
+	return true
+}
+
+func filterValues(f map[string]interface{}) []string {
+	var values []string
+	for _, v := range f["values"].([]interface{}) {
+		s, _ := v.(string)
+		values = append(values, s)
+	}
+
+	return values
+}
+
+// filterParamString is the setter of a request parameter which takes a single value
+func filterParamString(param **string) func(values []string) bool {
+	return func(values []string) bool {
+		if *param != nil || len(values) != 1 {
+			return false
+		}
+		*param = ncloud.String(values[0])
+		return true
+	}
+}
+
+// filterParamStringList is the setter of a list request parameter. e.g. subnetNoList
+func filterParamStringList(param *[]*string) func(values []string) bool {
+	return func(values []string) bool {
+		if *param != nil {
+			return false
+		}
+		*param = ncloud.StringList(values)
+		return true
+	}
 }
 
 //Converts the filter name which is delimited by '.' into a list of XPath elements
@@ -217,62 +297,3 @@ func checkAndConvertNestedStructure(element interface{}) (map[string]interface{}
 
 	return nil, false
 }
-
-type StringCheck func(propertyVal string, filterVal string) bool
-
-// orComparator returns true for any filter that matches the target property
-func orComparator(target interface{}, filters []interface{}, stringsEqual StringCheck) bool {
-	// Use reflection to determine whether the underlying type of the filtering attribute is a string or
-	// array of strings. Mainly used because the property could be an SDK enum with underlying string type.
-	val := reflect.ValueOf(target)
-	valType := val.Type()
-
-	for _, fVal := range filters {
-		switch valType.Kind() {
-		case reflect.Bool:
-			fBool, err := strconv.ParseBool(fVal.(string))
-			if err != nil {
-				log.Println("[WARN] Filtering against Type Bool field with un-parsable string boolean form")
-				return false
-			}
-			if val.Bool() == fBool {
-				return true
-			}
-		case reflect.Int, reflect.Int32, reflect.Int64:
-			// the target field is of type int, but the filter values list element type is string, users can supply string
-			// or int like `values = [300, "3600"]` but terraform will converts to string, so use ParseInt
-			fInt, err := strconv.ParseInt(fVal.(string), 10, 64)
-			if err != nil {
-				log.Println("[WARN] Filtering against Type Int field with non-int filter value")
-				return false
-			}
-			if val.Int() == fInt {
-				return true
-			}
-		case reflect.Float64:
-			// same comment as above for Ints
-			fFloat, err := strconv.ParseFloat(fVal.(string), 64)
-			if err != nil {
-				log.Println("[WARN] Filtering against Type Float field with non-float filter value")
-				return false
-			}
-			if val.Float() == fFloat {
-				return true
-			}
-		case reflect.String:
-			if stringsEqual(val.String(), fVal.(string)) {
-				return true
-			}
-		case reflect.Slice, reflect.Array:
-			if valType.Elem().Kind() == reflect.String {
-				arrLen := val.Len()
-				for i := 0; i < arrLen; i++ {
-					if stringsEqual(val.Index(i).String(), fVal.(string)) {
-						return true
-					}
-				}
-			}
-		}
-	}
-	return false
-}
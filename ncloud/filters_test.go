@@ -0,0 +1,233 @@
+package ncloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testFilterSet(filters ...map[string]interface{}) *schema.Set {
+	s := dataSourceFiltersSchema()
+	set := schema.NewSet(schema.HashResource(s.Elem.(*schema.Resource)), nil)
+	for _, f := range filters {
+		for k, v := range map[string]interface{}{"regex": false, "operator": filterOperatorEq, "match_all": false} {
+			if _, ok := f[k]; !ok {
+				f[k] = v
+			}
+		}
+		set.Add(f)
+	}
+
+	return set
+}
+
+func testFilterItems() []map[string]interface{} {
+	return []map[string]interface{}{
+		{"id": "1", "product_code": "SVR.VSVR.STAND.C002.M008.NET.SSD.B050.G002", "cpu_count": 2, "memory_size": "8GB", "create_date": "2020-12-01T14:22:34+0900", "private_ip": "10.0.1.6", "subnet": "10.0.1.0/24", "tags": []interface{}{"web", "prod"}},
+		{"id": "2", "product_code": "SVR.VSVR.STAND.C004.M016.NET.SSD.B050.G002", "cpu_count": 4, "memory_size": "16GB", "create_date": "2021-06-01T09:00:00+0900", "private_ip": "10.0.2.6", "subnet": "10.0.2.0/24", "tags": []interface{}{"db"}},
+		{"id": "3", "product_code": "SVR.VSVR.HICPU.C016.M032.NET.SSD.B050.G002", "cpu_count": 16, "memory_size": "32GB", "create_date": "2022-01-10T09:00:00+0900", "private_ip": "172.16.0.6", "subnet": "172.16.0.0/16", "tags": []interface{}{"batch", "prod"}},
+	}
+}
+
+var testFilterSchema = map[string]*schema.Schema{
+	"id":           {Type: schema.TypeString},
+	"product_code": {Type: schema.TypeString},
+	"cpu_count":    {Type: schema.TypeInt},
+	"memory_size":  {Type: schema.TypeString},
+	"create_date":  {Type: schema.TypeString},
+	"private_ip":   {Type: schema.TypeString},
+	"subnet":       {Type: schema.TypeString},
+	"tags":         {Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+}
+
+func testFilterIds(items []map[string]interface{}) string {
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item["id"].(string))
+	}
+
+	return strings.Join(ids, ",")
+}
+
+func TestApplyFilters_operators(t *testing.T) {
+	cases := []struct {
+		name     string
+		filter   map[string]interface{}
+		expected string
+	}{
+		{"eq", map[string]interface{}{"name": "cpu_count", "values": []interface{}{"2", "16"}}, "1,3"},
+		{"regex", map[string]interface{}{"name": "product_code", "values": []interface{}{"HICPU"}, "regex": true}, "3"},
+		{"ne match_all", map[string]interface{}{"name": "cpu_count", "values": []interface{}{"2", "16"}, "operator": "ne", "match_all": true}, "2"},
+		{"ne", map[string]interface{}{"name": "cpu_count", "values": []interface{}{"2", "16"}, "operator": "ne"}, "2"},
+		{"ne regex", map[string]interface{}{"name": "product_code", "values": []interface{}{"HICPU", "M016"}, "operator": "ne", "regex": true}, "1"},
+		{"gte memory with unit", map[string]interface{}{"name": "memory_size", "values": []interface{}{"16GB"}, "operator": "gte"}, "2,3"},
+		{"gt memory without unit", map[string]interface{}{"name": "memory_size", "values": []interface{}{"16"}, "operator": "gt"}, "3"},
+		{"lt memory in MB", map[string]interface{}{"name": "memory_size", "values": []interface{}{"16384MB"}, "operator": "lt"}, "1"},
+		{"lte cpu", map[string]interface{}{"name": "cpu_count", "values": []interface{}{"4"}, "operator": "lte"}, "1,2"},
+		{"gt date", map[string]interface{}{"name": "create_date", "values": []interface{}{"2021-01-01"}, "operator": "gt"}, "2,3"},
+		{"date range", map[string]interface{}{"name": "create_date", "values": []interface{}{"2021-01-01T00:00:00+09:00"}, "operator": "lt"}, "1"},
+		{"prefix", map[string]interface{}{"name": "product_code", "values": []interface{}{"SVR.VSVR.STAND"}, "operator": "prefix"}, "1,2"},
+		{"contains list", map[string]interface{}{"name": "tags", "values": []interface{}{"pro"}, "operator": "contains"}, "1,3"},
+		{"contains match_all", map[string]interface{}{"name": "product_code", "values": []interface{}{"STAND", "M016"}, "operator": "contains", "match_all": true}, "2"},
+		{"in_cidr ip", map[string]interface{}{"name": "private_ip", "values": []interface{}{"10.0.0.0/16"}, "operator": "in_cidr"}, "1,2"},
+		{"in_cidr block", map[string]interface{}{"name": "subnet", "values": []interface{}{"172.16.0.0/12", "10.0.1.0/24"}, "operator": "in_cidr"}, "1,3"},
+	}
+
+	for _, tc := range cases {
+		items, err := ApplyFilters(testFilterSet(tc.filter), testFilterItems(), testFilterSchema)
+		if err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+			continue
+		}
+		if ids := testFilterIds(items); ids != tc.expected {
+			t.Errorf("%s: Expected: %s, Actual: %s", tc.name, tc.expected, ids)
+		}
+	}
+}
+
+func TestApplyFilters_multipleFilters(t *testing.T) {
+	filters := testFilterSet(
+		map[string]interface{}{"name": "memory_size", "values": []interface{}{"16GB"}, "operator": "gte"},
+		map[string]interface{}{"name": "tags", "values": []interface{}{"prod"}},
+	)
+
+	items, err := ApplyFilters(filters, testFilterItems(), testFilterSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := testFilterIds(items); ids != "3" {
+		t.Fatalf("Expected: 3, Actual: %s", ids)
+	}
+}
+
+func TestApplyFilters_invalid(t *testing.T) {
+	cases := []struct {
+		name   string
+		filter map[string]interface{}
+		err    string
+	}{
+		{"regex", map[string]interface{}{"name": "product_code", "values": []interface{}{"HICPU("}, "regex": true}, "invalid regular expression"},
+		{"regex operator", map[string]interface{}{"name": "product_code", "values": []interface{}{"SVR"}, "regex": true, "operator": "prefix"}, "regex is only supported"},
+		{"cidr", map[string]interface{}{"name": "private_ip", "values": []interface{}{"10.0.0.0"}, "operator": "in_cidr"}, "invalid CIDR block"},
+		{"number", map[string]interface{}{"name": "cpu_count", "values": []interface{}{"many"}, "operator": "gt"}, "needs a number or a date"},
+	}
+
+	for _, tc := range cases {
+		if _, err := ApplyFilters(testFilterSet(tc.filter), testFilterItems(), testFilterSchema); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: expected error %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestParseFilterQuantity(t *testing.T) {
+	for s, expected := range map[string]float64{"16": 16, "16GB": 16, "2.5 TB": 2.5, "-1": -1} {
+		if n, _, ok := parseFilterQuantity(s); !ok || n != expected {
+			t.Errorf("%s: Expected: %v, Actual: %v", s, expected, n)
+		}
+	}
+
+	for _, s := range []string{"", "GB", "16 cores", "2021-01-01"} {
+		if _, _, ok := parseFilterQuantity(s); ok {
+			t.Errorf("%s: Expected not to be a quantity", s)
+		}
+	}
+}
+
+func TestPushDownFilters(t *testing.T) {
+	var vpcNo, zoneCode *string
+	var subnetNoList []*string
+	params := filterRequestParams{
+		"vpc_no":    filterParamString(&vpcNo),
+		"zone":      filterParamString(&zoneCode),
+		"subnet_no": filterParamStringList(&subnetNoList),
+		"name":      filterParamString(new(*string)),
+	}
+
+	filters := pushDownFilters(testFilterSet(
+		map[string]interface{}{"name": "vpc_no", "values": []interface{}{"1001"}},
+		map[string]interface{}{"name": "subnet_no", "values": []interface{}{"2001", "2002"}},
+		// A single value parameter can't take two values
+		map[string]interface{}{"name": "zone", "values": []interface{}{"KR-1", "KR-2"}},
+		map[string]interface{}{"name": "name", "values": []interface{}{"^web"}, "regex": true},
+		map[string]interface{}{"name": "usage_type", "values": []interface{}{"GEN"}},
+	), params)
+
+	if vpcNo == nil || *vpcNo != "1001" {
+		t.Fatalf("Expected vpc_no to be pushed down, got %v", vpcNo)
+	}
+	if len(subnetNoList) != 2 {
+		t.Fatalf("Expected subnet_no to be pushed down, got %v", subnetNoList)
+	}
+	if zoneCode != nil {
+		t.Fatalf("Expected zone not to be pushed down, got %s", *zoneCode)
+	}
+
+	var remaining []string
+	for _, f := range filters.List() {
+		remaining = append(remaining, f.(map[string]interface{})["name"].(string))
+	}
+	if filters.Len() != 3 {
+		t.Fatalf("Expected zone, name and usage_type to be applied locally, got %v", remaining)
+	}
+}
+
+func TestPushDownFilters_notPushed(t *testing.T) {
+	cases := []map[string]interface{}{
+		{"name": "vpc_no", "values": []interface{}{"1001"}, "operator": "ne"},
+		{"name": "vpc_no", "values": []interface{}{"100"}, "operator": "prefix"},
+		{"name": "vpc_no", "values": []interface{}{"1001"}, "regex": true},
+		{"name": "vpc_no", "values": []interface{}{}},
+		{"name": "subnet_no", "values": []interface{}{"2001", "2002"}, "match_all": true},
+	}
+
+	for _, f := range cases {
+		vpcNo := ncloud.String("1002")
+		var subnetNoList []*string
+		filters := pushDownFilters(testFilterSet(f), filterRequestParams{
+			"vpc_no":    filterParamString(&vpcNo),
+			"subnet_no": filterParamStringList(&subnetNoList),
+		})
+
+		if filters.Len() != 1 || *vpcNo != "1002" || subnetNoList != nil {
+			t.Errorf("Expected %v to be applied locally", f)
+		}
+	}
+
+	// The parameter set by an argument is kept, and the filter is applied locally
+	vpcNo := ncloud.String("1002")
+	filters := pushDownFilters(testFilterSet(map[string]interface{}{"name": "vpc_no", "values": []interface{}{"1001"}}), filterRequestParams{
+		"vpc_no": filterParamString(&vpcNo),
+	})
+	if filters.Len() != 1 || *vpcNo != "1002" {
+		t.Errorf("Expected the argument to be kept, got %s", *vpcNo)
+	}
+}
+
+func TestPushDownFilters_emulator(t *testing.T) {
+	e := newTestEmulator(t)
+	_, config := testEmulatorProvider(t, e, true)
+	vpcNo, subnetNo := e.seedVpc("10.0.0.0/16", "10.0.1.0/24")
+	e.seedSubnet(vpcNo, "10.0.2.0/24", "PRIVATE", "GEN")
+	otherVpcNo, _ := e.seedVpc("10.1.0.0/16", "10.1.1.0/24")
+
+	d := schema.TestResourceDataRaw(t, dataSourceNcloudSubnets().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "vpc_no", "values": []interface{}{vpcNo}},
+			map[string]interface{}{"name": "subnet", "values": []interface{}{"10.0.1.0/24", "10.1.1.0/24"}, "operator": "in_cidr"},
+		},
+	})
+
+	resources, err := getSubnetListFiltered(d, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if form := e.lastForm("vpc", "getSubnetList"); form.Get("vpcNo") != vpcNo {
+		t.Fatalf("Expected vpc_no filter to be sent as vpcNo, got %v", form)
+	}
+	if len(resources) != 1 || resources[0]["id"] != subnetNo {
+		t.Fatalf("Expected only the subnet %s of the vpc %s and not of %s, got %v", subnetNo, vpcNo, otherVpcNo, resources)
+	}
+}
@@ -21,6 +21,8 @@ func GetDataSourceItemSchema(resourceSchema *schema.Resource) *schema.Resource {
 	// as though they were resources.
 	resourceSchema.Create = nil
 	resourceSchema.Read = nil
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
 
 	return convertResourceFieldsToDatasourceFields(resourceSchema)
 }
@@ -36,6 +38,10 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 
 	// Ensure Create,Read, Update and Delete are not set for data source schemas. Otherwise, terraform will validate them
 	// as though they were resources.
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
+	resourceSchema.UpdateContext = nil
+	resourceSchema.DeleteContext = nil
 	resourceSchema.Create = nil
 	resourceSchema.Update = nil
 	resourceSchema.Delete = nil
@@ -50,6 +56,8 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 		dataSourceSchema.Schema[key] = value
 	}
 
+	setDataSourceSortSchema(dataSourceSchema.Schema)
+
 	return dataSourceSchema
 }
 
@@ -82,6 +90,8 @@ func GetSingularDataSourceItemSchemaContext(resourceSchema *schema.Resource, add
 		dataSourceSchema.Schema[key] = value
 	}
 
+	setDataSourceSortSchema(dataSourceSchema.Schema)
+
 	return dataSourceSchema
 }
 
@@ -100,7 +110,7 @@ func convertResourceFieldsToDatasourceFields(resourceSchema *schema.Resource) *s
 		fieldSchema.ConflictsWith = nil
 		fieldSchema.Default = nil
 		fieldSchema.MaxItems = 0
-		fieldSchema.MaxItems = 0
+		fieldSchema.MinItems = 0
 		if fieldSchema.Type == schema.TypeSet {
 			fieldSchema.Type = schema.TypeList
 			fieldSchema.Set = nil
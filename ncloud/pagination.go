@@ -0,0 +1,41 @@
+package ncloud
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+// defaultPageSize is the number of rows requested for each page of the list APIs
+const defaultPageSize int32 = 100
+
+// paginate fetches every page of a list API.
+// It sets pageNo and pageSize of the request and calls fetch until the rows of TotalRows are fetched.
+// fetch returns the number of rows of the page and TotalRows of the response, e.g.
+//
+//	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+//		resp, err := client.vserver.V2Api.GetServerInstanceList(reqParams)
+//		if err != nil {
+//			return 0, nil, err
+//		}
+//		list = append(list, resp.ServerInstanceList...)
+//		return len(resp.ServerInstanceList), resp.TotalRows, nil
+//	})
+func paginate(pageNo, pageSize **int32, fetch func() (int, *int32, error)) error {
+	if *pageSize == nil {
+		*pageSize = ncloud.Int32(defaultPageSize)
+	}
+
+	var fetched int32
+	for no := int32(1); ; no++ {
+		*pageNo = ncloud.Int32(no)
+
+		count, totalRows, err := fetch()
+		if err != nil {
+			return err
+		}
+
+		fetched += int32(count)
+		if count == 0 || totalRows == nil || fetched >= *totalRows {
+			return nil
+		}
+	}
+}
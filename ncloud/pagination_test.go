@@ -0,0 +1,103 @@
+package ncloud
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPaginate(t *testing.T) {
+	var pageNo, pageSize *int32
+	var pages []int32
+
+	err := paginate(&pageNo, &pageSize, func() (int, *int32, error) {
+		pages = append(pages, *pageNo)
+		if *pageNo < 3 {
+			return int(*pageSize), ncloud.Int32(250), nil
+		}
+		return 50, ncloud.Int32(250), nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 3 || pages[0] != 1 || pages[2] != 3 {
+		t.Fatalf("Expected pages 1 to 3, got %v", pages)
+	}
+	if *pageSize != defaultPageSize {
+		t.Fatalf("Expected: %d, Actual: %d", defaultPageSize, *pageSize)
+	}
+}
+
+func TestPaginate_stop(t *testing.T) {
+	cases := []struct {
+		name      string
+		count     int
+		totalRows *int32
+	}{
+		{"empty page", 0, ncloud.Int32(10)},
+		{"no total rows", 100, nil},
+		{"last page", 10, ncloud.Int32(10)},
+	}
+
+	for _, tc := range cases {
+		var pageNo, pageSize *int32
+		calls := 0
+		err := paginate(&pageNo, &pageSize, func() (int, *int32, error) {
+			calls++
+			return tc.count, tc.totalRows, nil
+		})
+
+		if err != nil || calls != 1 {
+			t.Errorf("%s: expected a single call, got %d calls, error: %v", tc.name, calls, err)
+		}
+	}
+}
+
+func TestPaginate_error(t *testing.T) {
+	var pageNo, pageSize *int32
+	pageSize = ncloud.Int32(10)
+
+	err := paginate(&pageNo, &pageSize, func() (int, *int32, error) {
+		if *pageNo == 2 {
+			return 0, nil, errors.New("failed")
+		}
+		return 10, ncloud.Int32(30), nil
+	})
+
+	if err == nil || err.Error() != "failed" {
+		t.Fatalf("Expected the error of the second page, got %v", err)
+	}
+	if *pageSize != 10 {
+		t.Fatalf("Expected the page size of the request to be kept, got %d", *pageSize)
+	}
+}
+
+func TestPaginate_emulator(t *testing.T) {
+	e := newTestEmulator(t)
+	_, config := testEmulatorProvider(t, e, true)
+	vpcNo, _ := e.seedVpc("10.0.0.0/16", "10.0.0.0/24")
+
+	e.mu.Lock()
+	for i := 0; i < 249; i++ {
+		e.createEmulatorAccessControlGroup(ncloud.String(vpcNo), nil, false)
+	}
+	e.mu.Unlock()
+
+	d := schema.TestResourceDataRaw(t, dataSourceNcloudAccessControlGroups().Schema, map[string]interface{}{
+		"vpc_no": vpcNo,
+	})
+
+	resources, err := getVpcAccessControlGroupList(d, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 250 {
+		t.Fatalf("Expected all 250 access control groups, got %d", len(resources))
+	}
+	if n := e.callCount("vserver", "getAccessControlGroupList"); n != 3 {
+		t.Fatalf("Expected 3 pages, got %d calls", n)
+	}
+}
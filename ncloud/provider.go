@@ -2,9 +2,11 @@ package ncloud
 
 import (
 	"fmt"
-	"os"
+	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var NcloudResources map[string]*schema.Resource
@@ -23,16 +25,29 @@ func schemaMap() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"access_key": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
 			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_ACCESS_KEY", nil),
 			Description: descriptions["access_key"],
 		},
 		"secret_key": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Sensitive:   true,
 			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_SECRET_KEY", nil),
 			Description: descriptions["secret_key"],
 		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_PROFILE", nil),
+			Description: descriptions["profile"],
+		},
+		"shared_credentials_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_SHARED_CREDENTIALS_FILE", nil),
+			Description: descriptions["shared_credentials_file"],
+		},
 		"region": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -51,24 +66,137 @@ func schemaMap() map[string]*schema.Schema {
 			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_SUPPORT_VPC", nil),
 			Description: descriptions["support_vpc"],
 		},
+		"endpoints": endpointsSchema(),
+		"default_tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions["default_tags"],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"max_retries": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          DefaultMaxRetries,
+			ValidateDiagFunc: ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      descriptions["max_retries"],
+		},
+		"retry_max_backoff": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          DefaultRetryMaxBackoff.String(),
+			ValidateDiagFunc: ToDiagFunc(validateParseDuration),
+			Description:      descriptions["retry_max_backoff"],
+		},
+		"max_requests_per_second": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			ValidateDiagFunc: ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      descriptions["max_requests_per_second"],
+		},
+		"max_concurrent_mutations": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			ValidateDiagFunc: ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      descriptions["max_concurrent_mutations"],
+		},
+		"read_only": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_READ_ONLY", false),
+			Description: descriptions["read_only"],
+		},
+		"skip_region_validation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: descriptions["skip_region_validation"],
+		},
+		"skip_credentials_validation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: descriptions["skip_credentials_validation"],
+		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsSchema := map[string]*schema.Schema{}
+
+	for _, service := range endpointServiceNames {
+		endpointsSchema[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Use this to override the default base URL of the `%s` API", service),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["endpoints"],
+		Elem: &schema.Resource{
+			Schema: endpointsSchema,
+		},
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) map[string]string {
+	tags := make(map[string]string)
+
+	if len(l) == 0 || l[0] == nil {
+		return tags
+	}
+
+	for k, v := range l[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+
+	return tags
+}
+
+func expandProviderEndpoints(l []interface{}) map[string]string {
+	endpoints := make(map[string]string)
+
+	if len(l) == 0 || l[0] == nil {
+		return endpoints
+	}
+
+	for service, endpoint := range l[0].(map[string]interface{}) {
+		if v, ok := endpoint.(string); ok && v != "" {
+			endpoints[service] = v
+		}
+	}
+
+	return endpoints
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	return configureProvider(d, http.DefaultTransport)
+}
+
+// configureProvider configures the provider with the base transport of the SDK clients, which tests replace to record or replay the API calls
+func configureProvider(d *schema.ResourceData, transport http.RoundTripper) (interface{}, error) {
 	providerConfig := ProviderConfig{
-		SupportVPC: d.Get("support_vpc").(bool),
+		SupportVPC:  d.Get("support_vpc").(bool),
+		DefaultTags: expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 	}
 
 	// Set site
 	if site, ok := d.GetOk("site"); ok {
 		providerConfig.Site = site.(string)
-
-		switch site {
-		case "gov":
-			os.Setenv("NCLOUD_API_GW", "https://ncloud.apigw.gov-ntruss.com")
-		case "fin":
-			os.Setenv("NCLOUD_API_GW", "https://fin-ncloud.apigw.fin-ntruss.com")
-		}
 	}
 
 	// Fin only supports VPC
@@ -76,11 +204,28 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		providerConfig.SupportVPC = true
 	}
 
+	retryMaxBackoff, err := time.ParseDuration(d.Get("retry_max_backoff").(string))
+	if err != nil {
+		return nil, err
+	}
+
 	// Set client
 	config := Config{
-		AccessKey: d.Get("access_key").(string),
-		SecretKey: d.Get("secret_key").(string),
-		Region:    d.Get("region").(string),
+		AccessKey:             d.Get("access_key").(string),
+		SecretKey:             d.Get("secret_key").(string),
+		Profile:               d.Get("profile").(string),
+		SharedCredentialsFile: d.Get("shared_credentials_file").(string),
+		Region:                d.Get("region").(string),
+		Site:                  providerConfig.Site,
+		Endpoints:             expandProviderEndpoints(d.Get("endpoints").([]interface{})),
+		MaxRetries:            d.Get("max_retries").(int),
+		RetryMaxBackoff:       retryMaxBackoff,
+		ReadOnly:              d.Get("read_only").(bool),
+		Transport:             transport,
+
+		MaxRequestsPerSecond:      d.Get("max_requests_per_second").(int),
+		MaxConcurrentMutations:    d.Get("max_concurrent_mutations").(int),
+		SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
 	}
 
 	if client, err := config.Client(); err != nil {
@@ -89,16 +234,18 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		providerConfig.Client = client
 	}
 
-	// Set region
-	if err := setRegionCache(providerConfig.Client, providerConfig.SupportVPC); err != nil {
+	// Set region. The built-in region table is used instead of the API when the validation is skipped, so that no API is called here
+	skipRegionValidation := d.Get("skip_region_validation").(bool)
+	if skipRegionValidation || config.SkipCredentialsValidation {
+		setStaticRegionCache(&providerConfig)
+	} else if err := loadRegionCache(&providerConfig); err != nil {
 		return nil, err
 	}
 
-	if region, ok := d.GetOk("region"); ok && isValidRegionCode(region.(string)) {
-		os.Setenv("NCLOUD_REGION", region.(string))
+	if region, ok := d.GetOk("region"); ok && (skipRegionValidation || isValidRegionCode(&providerConfig, region.(string))) {
 		providerConfig.RegionCode = region.(string)
-		if !providerConfig.SupportVPC {
-			providerConfig.RegionNo = *regionCacheByCode[region.(string)].RegionNo
+		if r, ok := providerConfig.getRegionCache(providerConfig.RegionCode); ok && r.RegionNo != nil && !providerConfig.SupportVPC {
+			providerConfig.RegionNo = *r.RegionNo
 		}
 	} else {
 		return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", region)
@@ -111,11 +258,22 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
-		"access_key":  "Access key of ncloud",
-		"secret_key":  "Secret key of ncloud",
-		"region":      "Region of ncloud",
-		"site":        "Site of ncloud (public / gov / fin)",
-		"support_vpc": "Support VPC platform",
+		"access_key":                  "Access key of ncloud",
+		"secret_key":                  "Secret key of ncloud",
+		"profile":                     "Profile of the shared credentials file",
+		"shared_credentials_file":     "Path of the shared credentials file. Default: $HOME/.ncloud/configure",
+		"region":                      "Region of ncloud",
+		"site":                        "Site of ncloud (public / gov / fin)",
+		"support_vpc":                 "Support VPC platform",
+		"endpoints":                   "Override the default base URL of each service API",
+		"default_tags":                "Tags applied to every resource that supports instance tags",
+		"max_retries":                 "Maximum number of times an API call is retried on retryable errors",
+		"retry_max_backoff":           "Maximum backoff between retries of an API call (e.g. 30s)",
+		"max_requests_per_second":     "Maximum number of API calls per second to each service. 0 means no limit",
+		"max_concurrent_mutations":    "Maximum number of API calls which create, update or delete resources in flight to each service. 0 means no limit",
+		"read_only":                   "Block every API call which may create, update or delete resources. Data sources and refresh still work",
+		"skip_region_validation":      "Skip the validation of the region against the API. The built-in region table is used and unknown regions are allowed",
+		"skip_credentials_validation": "Skip the validation of the credentials when the provider is configured. The errors are returned by the first API call instead",
 	}
 }
 
@@ -63,7 +63,7 @@ func getTestAccProvider(isVpc bool) *schema.Provider {
 		ConfigureFunc: func(d *schema.ResourceData) (interface{}, error) {
 			d.Set("region", testAccGetRegion())
 			d.Set("support_vpc", isVpc)
-			return providerConfigure(d)
+			return configureProvider(d, testAccTransport)
 		},
 	}
 
@@ -81,6 +81,8 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	testAccUseCassette(t)
+
 	if v := multiEnvSearch(credsEnvVars); v == "" {
 		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
 	}
@@ -0,0 +1,143 @@
+package ncloud
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimitPause is how long the API calls of a service are held back after 429 Too Many Requests without Retry-After
+const rateLimitPause = 1 * time.Second
+
+// rateLimiter is a token bucket which allows requestsPerSecond calls with bursts of the same size.
+// No limit is applied when requestsPerSecond is 0, but the calls are still held back after the API throttled them.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	// next is the time when the next call would be allowed if the calls were evenly spaced
+	next        time.Time
+	pausedUntil time.Time
+}
+
+func newRateLimiter(requestsPerSecond int) *rateLimiter {
+	l := &rateLimiter{burst: requestsPerSecond}
+	if requestsPerSecond > 0 {
+		l.interval = time.Second / time.Duration(requestsPerSecond)
+	}
+
+	return l
+}
+
+// reserve takes a token and returns how long to wait for it
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	allowAt := now
+	if l.interval > 0 {
+		if l.next.Before(now) {
+			l.next = now
+		}
+		// The bucket holds burst tokens, so a call is allowed up to burst-1 intervals ahead of its turn
+		if at := l.next.Add(-time.Duration(l.burst-1) * l.interval); at.After(allowAt) {
+			allowAt = at
+		}
+	}
+	if l.pausedUntil.After(allowAt) {
+		allowAt = l.pausedUntil
+	}
+	if l.interval > 0 {
+		if allowAt.After(l.next) {
+			l.next = allowAt
+		}
+		l.next = l.next.Add(l.interval)
+	}
+
+	return allowAt.Sub(now)
+}
+
+// wait blocks until the call is allowed or the context is done
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return delay, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// pause holds back the following calls for d, when the API answers with 429 Too Many Requests
+func (l *rateLimiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// rateLimitTransport limits the rate of the API calls of a service and the number of mutating calls in flight.
+// It is wrapped by retryTransport, so that every retry also waits for the limiter.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	service   string
+	limiter   *rateLimiter
+	// mutations is the semaphore of the mutating calls in flight. nil if not limited
+	mutations chan struct{}
+}
+
+func newRateLimitTransport(transport http.RoundTripper, service string, requestsPerSecond int, maxConcurrentMutations int) *rateLimitTransport {
+	t := &rateLimitTransport{
+		transport: transport,
+		service:   service,
+		limiter:   newRateLimiter(requestsPerSecond),
+	}
+	if maxConcurrentMutations > 0 {
+		t.mutations = make(chan struct{}, maxConcurrentMutations)
+	}
+
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.mutations != nil && !isReadOperation(req) {
+		select {
+		case t.mutations <- struct{}{}:
+			defer func() { <-t.mutations }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	delay, err := t.limiter.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if delay >= time.Second {
+		log.Printf("[DEBUG] %s %s: waited %s for the rate limit", t.service, apiOperationName(req), delay)
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		pause := retryAfter(resp)
+		if pause == 0 {
+			pause = rateLimitPause
+		}
+		t.limiter.pause(pause)
+	}
+
+	return resp, err
+}
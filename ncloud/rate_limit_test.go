@@ -0,0 +1,134 @@
+package ncloud
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(2)
+	now := time.Now()
+
+	var delays []time.Duration
+	for i := 0; i < 5; i++ {
+		delays = append(delays, l.reserve(now))
+	}
+
+	expected := []time.Duration{0, 0, 500 * time.Millisecond, time.Second, 1500 * time.Millisecond}
+	for i := range expected {
+		if delays[i] != expected[i] {
+			t.Fatalf("Expected a burst of 2 and then 2 calls per second, got %v", delays)
+		}
+	}
+
+	// The bucket is refilled while no call is made
+	if delay := l.reserve(now.Add(10 * time.Second)); delay != 0 {
+		t.Fatalf("Expected no delay after idle, got %s", delay)
+	}
+}
+
+func TestRateLimiter_pause(t *testing.T) {
+	l := newRateLimiter(0)
+	if delay := l.reserve(time.Now()); delay != 0 {
+		t.Fatalf("Expected no limit, got %s", delay)
+	}
+
+	l.pause(time.Minute)
+	if delay := l.reserve(time.Now()); delay < 59*time.Second {
+		t.Fatalf("Expected the calls to be held back after 429, got %s", delay)
+	}
+
+	if _, err := l.wait(testCanceledContext()); err == nil {
+		t.Fatal("Expected the wait to be canceled with the context")
+	}
+}
+
+func testCanceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func TestRateLimitTransport_maxConcurrentMutations(t *testing.T) {
+	var inFlight, maxInFlight int32
+	transport := newRateLimitTransport(testRoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), "vserver", 0, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPost, "https://ncloud.apigw.ntruss.com/vserver/v2/createServerInstances", strings.NewReader("responseFormatType=json"))
+			transport.RoundTrip(req)
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Fatalf("Expected at most 2 mutating calls in flight, got %d", maxInFlight)
+	}
+}
+
+func TestRateLimitTransport_tooManyRequests(t *testing.T) {
+	var calls int32
+	transport := newRateLimitTransport(testRoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+			resp.Header.Set("Retry-After", "1")
+			return resp, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), "vserver", 0, 0)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://ncloud.apigw.ntruss.com/vserver/v2/getServerInstanceList", nil)
+	if resp, _ := transport.RoundTrip(req); resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429, got %d", resp.StatusCode)
+	}
+
+	// Other calls to the service back off as well
+	start := time.Now()
+	req, _ = http.NewRequest(http.MethodPost, "https://ncloud.apigw.ntruss.com/vserver/v2/getAccessControlGroupList", nil)
+	if resp, err := transport.RoundTrip(req); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("Expected the call to wait for Retry-After, waited %s", elapsed)
+	}
+}
+
+func TestRateLimit_emulator(t *testing.T) {
+	e := newTestEmulator(t)
+	_, config := testEmulatorProviderWithConfig(t, e, true, http.DefaultTransport, map[string]interface{}{
+		"max_requests_per_second": 20,
+	})
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if _, err := getVpcRegionList(config.Client); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 20 calls of the burst and 10 more calls at 20 calls per second. Configuring the provider took one token
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("Expected the calls to be limited to 20 per second, took %s", elapsed)
+	}
+}
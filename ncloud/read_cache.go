@@ -0,0 +1,74 @@
+package ncloud
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// defaultReadCacheTTL is how long the catalog data is reused before it is fetched again
+const defaultReadCacheTTL = 10 * time.Minute
+
+// readCache is a TTL cache of the catalog data which doesn't change during a run. e.g. zones, regions, server products and images
+// Concurrent calls of the same key wait for a single API call instead of calling the API each.
+type readCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*readCacheEntry
+}
+
+type readCacheEntry struct {
+	// ready is closed when value and err are set
+	ready   chan struct{}
+	value   interface{}
+	err     error
+	expires time.Time
+}
+
+// get returns the cached value of the key, or calls fetch and caches its result. Errors are not cached.
+func (c *readCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		select {
+		case <-e.ready:
+			if time.Now().Before(e.expires) {
+				c.mu.Unlock()
+				return e.value, nil
+			}
+		default:
+			c.mu.Unlock()
+			<-e.ready
+			return e.value, e.err
+		}
+	}
+
+	e := &readCacheEntry{ready: make(chan struct{})}
+	if c.entries == nil {
+		c.entries = make(map[string]*readCacheEntry)
+	}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	e.value, e.err = fetch()
+
+	ttl := c.ttl
+	if ttl == 0 {
+		ttl = defaultReadCacheTTL
+	}
+	e.expires = time.Now().Add(ttl)
+
+	c.mu.Lock()
+	if e.err != nil && c.entries[key] == e {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(e.ready)
+
+	return e.value, e.err
+}
+
+// readCacheKey is the cache key of the API call with the request params. e.g. vserver/getZoneList{"regionCode":"KR"}
+func readCacheKey(operation string, reqParams interface{}) string {
+	b, _ := json.Marshal(reqParams)
+	return operation + string(b)
+}
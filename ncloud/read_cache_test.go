@@ -0,0 +1,125 @@
+package ncloud
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestReadCache(t *testing.T) {
+	var c readCache
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if v, err := c.get("key", fetch); err != nil || v.(int) != 1 {
+			t.Fatalf("Expected the cached value, got %v, %v", v, err)
+		}
+	}
+
+	if v, _ := c.get("other", fetch); v.(int) != 2 {
+		t.Fatalf("Expected a separate value for another key, got %v", v)
+	}
+}
+
+func TestReadCache_errorNotCached(t *testing.T) {
+	var c readCache
+	calls := 0
+
+	_, err := c.get("key", func() (interface{}, error) {
+		calls++
+		return nil, errors.New("throttled")
+	})
+	if err == nil {
+		t.Fatal("Expected the error of fetch")
+	}
+
+	v, err := c.get("key", func() (interface{}, error) {
+		calls++
+		return "zones", nil
+	})
+	if err != nil || v.(string) != "zones" || calls != 2 {
+		t.Fatalf("Expected the failed call to be retried, got %v, %v after %d calls", v, err, calls)
+	}
+}
+
+func TestReadCache_expires(t *testing.T) {
+	c := readCache{ttl: time.Millisecond}
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	c.get("key", fetch)
+	time.Sleep(5 * time.Millisecond)
+
+	if v, _ := c.get("key", fetch); v.(int) != 2 {
+		t.Fatalf("Expected the expired value to be fetched again, got %v", v)
+	}
+}
+
+func TestReadCache_concurrent(t *testing.T) {
+	var c readCache
+	var calls int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.get("key", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return "zones", nil
+			})
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected concurrent calls to share a single fetch, got %d", calls)
+	}
+}
+
+func TestReadCacheKey(t *testing.T) {
+	kr := readCacheKey("vserver/getZoneList", &vserver.GetZoneListRequest{RegionCode: ncloud.String("KR")})
+	jpn := readCacheKey("vserver/getZoneList", &vserver.GetZoneListRequest{RegionCode: ncloud.String("JPN")})
+
+	if kr == jpn || kr != `vserver/getZoneList{"regionCode":"KR"}` {
+		t.Fatalf("Expected keys by the request params, got %s and %s", kr, jpn)
+	}
+}
+
+func TestReadCache_emulatorZones(t *testing.T) {
+	e := newTestEmulator(t)
+	_, config := testEmulatorProvider(t, e, true)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := getZoneByCode(config, "KR-2"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := e.callCount("vserver", "getZoneList"); n != 1 {
+		t.Fatalf("Expected a single getZoneList call, got %d", n)
+	}
+}
@@ -0,0 +1,41 @@
+package ncloud
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// readOnlyTransport rejects the API calls which may change resources before they are sent.
+// It is used when `read_only` of the provider is set, so that only data sources and refresh work.
+type readOnlyTransport struct {
+	transport http.RoundTripper
+	service   string
+}
+
+func newReadOnlyTransport(transport http.RoundTripper, service string) *readOnlyTransport {
+	return &readOnlyTransport{
+		transport: transport,
+		service:   service,
+	}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOperation(req) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, &readOnlyError{Service: t.service, Operation: apiOperationName(req)}
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// readOnlyError is returned for the API calls blocked by the read only mode
+type readOnlyError struct {
+	Service   string
+	Operation string
+}
+
+func (e *readOnlyError) Error() string {
+	return fmt.Sprintf("%s %s was blocked because the provider is read only", e.Service, e.Operation)
+}
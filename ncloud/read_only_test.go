@@ -0,0 +1,114 @@
+package ncloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type testRoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f testRoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	sent := 0
+	transport := newReadOnlyTransport(testRoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), "vpc")
+
+	for _, c := range []struct {
+		method  string
+		url     string
+		blocked bool
+	}{
+		{http.MethodPost, "https://ncloud.apigw.ntruss.com/vpc/v2/getVpcList", false},
+		{http.MethodGet, "https://nks.apigw.ntruss.com/vnks/v2/clusters", false},
+		{http.MethodPost, "https://ncloud.apigw.ntruss.com/vpc/v2/createVpc", true},
+		{http.MethodPost, "https://ncloud.apigw.ntruss.com/vpc/v2/deleteVpc", true},
+		{http.MethodDelete, "https://nks.apigw.ntruss.com/vnks/v2/clusters/1234", true},
+	} {
+		sent = 0
+		req, _ := http.NewRequest(c.method, c.url, strings.NewReader("responseFormatType=json"))
+
+		_, err := transport.RoundTrip(req)
+
+		var readOnlyErr *readOnlyError
+		if blocked := errors.As(err, &readOnlyErr); blocked != c.blocked || blocked == (sent == 1) {
+			t.Errorf("%s %s: expected blocked %t, got error %v after %d calls", c.method, c.url, c.blocked, err, sent)
+		}
+	}
+}
+
+func TestReadOnly_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_vpc"]
+
+	state := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"name":            "tf-emulator-vpc",
+		"ipv4_cidr_block": "10.0.0.0/16",
+	}, config)
+
+	_, readOnlyConfig := testEmulatorProviderWithConfig(t, e, true, http.DefaultTransport, map[string]interface{}{
+		"read_only": true,
+	})
+
+	refreshed := testEmulatorRefresh(t, r, state, readOnlyConfig)
+	testCheckEmulatorState(t, refreshed, map[string]string{
+		"name": "tf-emulator-vpc",
+	})
+
+	diff := testEmulatorPlan(t, r, nil, map[string]interface{}{
+		"name":            "tf-emulator-vpc-2",
+		"ipv4_cidr_block": "10.1.0.0/16",
+	}, readOnlyConfig)
+	if _, diags := r.Apply(context.Background(), nil, diff, readOnlyConfig); !diags.HasError() || diags[0].Summary != "The provider is read only" {
+		t.Fatalf("Expected creation to be blocked, got %#v", diags)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, readOnlyConfig); !diags.HasError() {
+		t.Fatal("Expected deletion to be blocked")
+	}
+
+	if e.callCount("vpc", "createVpc") != 1 || e.callCount("vpc", "deleteVpc") != 0 {
+		t.Fatalf("Expected no mutating calls to reach the API, got createVpc %d, deleteVpc %d", e.callCount("vpc", "createVpc"), e.callCount("vpc", "deleteVpc"))
+	}
+}
+
+func TestReadOnly_emulatorDestroyBlockStorage(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_block_storage"]
+
+	e.seedVpc("10.8.0.0/16", "10.8.0.0/24")
+	storage := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"name": "tf-emulator-storage",
+		"size": 10,
+		"zone": "KR-1",
+	}, config)
+
+	_, readOnlyConfig := testEmulatorProviderWithConfig(t, e, true, http.DefaultTransport, map[string]interface{}{
+		"read_only": true,
+	})
+
+	// The retry of the deletion checks the error code of the blocked call, which has no error body
+	_, diags := r.Apply(context.Background(), storage, &terraform.InstanceDiff{Destroy: true}, readOnlyConfig)
+	if !diags.HasError() || diags[0].Summary != "The provider is read only" {
+		t.Fatalf("Expected deletion to be blocked, got %#v", diags)
+	}
+
+	if e.callCount("vserver", "deleteBlockStorageInstances") != 0 {
+		t.Fatal("Expected no deleteBlockStorageInstances to reach the API")
+	}
+}
@@ -0,0 +1,109 @@
+package ncloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const redactedLogValue = "(sensitive value)"
+
+// sensitiveLogFields is the deny-list of SDK field names which are never logged.
+// A field is sensitive if its normalized name contains one of them. e.g. cifsUserPassword, privateKey, kubeconfig
+var sensitiveLogFields = []string{"password", "privatekey", "secretkey", "kubeconfig", "clientkey"}
+
+var (
+	sensitiveSchemaFieldsOnce sync.Once
+	sensitiveSchemaFields     map[string]bool
+)
+
+// sensitiveSchemaFieldNames returns the normalized names of the attributes marked as Sensitive
+// in the provider, resources and data sources.
+func sensitiveSchemaFieldNames() map[string]bool {
+	sensitiveSchemaFieldsOnce.Do(func() {
+		sensitiveSchemaFields = map[string]bool{}
+		collectSensitiveFieldNames(schemaMap(), sensitiveSchemaFields)
+		for _, r := range NcloudResources {
+			collectSensitiveFieldNames(r.Schema, sensitiveSchemaFields)
+		}
+		for _, r := range NcloudDataSources {
+			collectSensitiveFieldNames(r.Schema, sensitiveSchemaFields)
+		}
+	})
+
+	return sensitiveSchemaFields
+}
+
+func collectSensitiveFieldNames(s map[string]*schema.Schema, names map[string]bool) {
+	for k, v := range s {
+		if v.Sensitive {
+			names[normalizeLogFieldName(k)] = true
+		}
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			collectSensitiveFieldNames(r.Schema, names)
+		}
+	}
+}
+
+// normalizeLogFieldName makes the attribute names and the SDK field names comparable. e.g. cifs_user_password, cifsUserPassword
+func normalizeLogFieldName(name string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+}
+
+func isSensitiveLogField(name string) bool {
+	n := normalizeLogFieldName(name)
+	if sensitiveSchemaFieldNames()[n] {
+		return true
+	}
+
+	for _, field := range sensitiveLogFields {
+		if strings.Contains(n, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactedJSON marshals v for the logs, replacing the values of sensitive fields with redactedLogValue.
+func redactedJSON(v interface{}) []byte {
+	b, _ := json.Marshal(v)
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || !redactValue(value) {
+		return b
+	}
+
+	redacted, _ := json.Marshal(value)
+	return redacted
+}
+
+// redactValue replaces the sensitive fields of the decoded JSON value in place and reports whether any was found.
+func redactValue(v interface{}) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSensitiveLogField(k) {
+				if e != nil {
+					v[k] = redactedLogValue
+					redacted = true
+				}
+				continue
+			}
+			redacted = redactValue(e) || redacted
+		}
+	case []interface{}:
+		for _, e := range v {
+			redacted = redactValue(e) || redacted
+		}
+	}
+
+	return redacted
+}
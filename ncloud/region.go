@@ -2,10 +2,11 @@ package ncloud
 
 import (
 	"fmt"
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"os"
+	"log"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,11 +16,9 @@ type Region struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-var regionCacheByCode = make(map[string]Region)
-
-func parseRegionNoParameter(d *schema.ResourceData) (*string, error) {
+func parseRegionNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		regionNo := getRegionNoByCode(regionCode.(string))
+		regionNo := getRegionNoByCode(config, regionCode.(string))
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
 		}
@@ -27,8 +26,8 @@ func parseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		regionNo := getRegionNoByCode(regionCode)
+	if regionCode := config.RegionCode; regionCode != "" {
+		regionNo := getRegionNoByCode(config, regionCode)
 		if regionNo == nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
 		}
@@ -38,9 +37,9 @@ func parseRegionNoParameter(d *schema.ResourceData) (*string, error) {
 	return nil, nil
 }
 
-func parseRegionCodeParameter(client *NcloudAPIClient, d *schema.ResourceData) (*string, error) {
+func parseRegionCodeParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		region, err := getRegionByCode(client, regionCode.(string))
+		region, err := getRegionByCode(config, regionCode.(string))
 		if region == nil || err != nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
 		}
@@ -48,8 +47,8 @@ func parseRegionCodeParameter(client *NcloudAPIClient, d *schema.ResourceData) (
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		region, err := getRegionByCode(client, regionCode)
+	if regionCode := config.RegionCode; regionCode != "" {
+		region, err := getRegionByCode(config, regionCode)
 		if region == nil || err != nil {
 			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
 		}
@@ -59,19 +58,32 @@ func parseRegionCodeParameter(client *NcloudAPIClient, d *schema.ResourceData) (
 	return nil, nil
 }
 
-func getRegionNoByCode(code string) *string {
-	if region, ok := regionCacheByCode[code]; ok {
+func getRegionNoByCode(config *ProviderConfig, code string) *string {
+	if region, ok := config.getRegionCache(code); ok && region.RegionNo != nil {
+		return region.RegionNo
+	}
+
+	// The built-in region table may not know the region, so load the regions from the API on the first miss
+	if err := loadRegionCache(config); err != nil {
+		log.Printf("[WARN] unable to load regions: %s", err)
+		return nil
+	}
+
+	if region, ok := config.getRegionCache(code); ok {
 		return region.RegionNo
 	}
 	return nil
 }
 
-func getRegionByCode(client *NcloudAPIClient, code string) (*server.Region, error) {
-	resp, err := client.server.V2Api.GetRegionList(&server.GetRegionListRequest{})
+func getRegionByCode(config *ProviderConfig, code string) (*server.Region, error) {
+	reqParams := &server.GetRegionListRequest{}
+	v, err := config.readCache.get(readCacheKey("server/getRegionList", reqParams), func() (interface{}, error) {
+		return config.Client.server.V2Api.GetRegionList(reqParams)
+	})
 	if err != nil {
 		return nil, err
 	}
-	regionList := resp.RegionList
+	regionList := v.(*server.GetRegionListResponse).RegionList
 
 	var filteredRegion *server.Region
 	for _, region := range regionList {
@@ -84,13 +96,41 @@ func getRegionByCode(client *NcloudAPIClient, code string) (*server.Region, erro
 	return filteredRegion, nil
 }
 
-func setRegionCache(client *NcloudAPIClient, supportVPC bool) error {
+// providerRegionNo returns the region number of the provider region.
+// It is resolved on the first use when the built-in region table of the provider doesn't know it.
+func providerRegionNo(config *ProviderConfig) *string {
+	if config.RegionNo != "" {
+		return ncloud.String(config.RegionNo)
+	}
+
+	return getRegionNoByCode(config, config.RegionCode)
+}
+
+// loadRegionCache fills the region cache from the API unless it was already done.
+// It is deferred until a resource needs it when the provider is configured with the built-in region table.
+func loadRegionCache(config *ProviderConfig) error {
+	config.regionLoadMutex.Lock()
+	defer config.regionLoadMutex.Unlock()
+
+	if config.regionCacheLoaded {
+		return nil
+	}
+
+	if err := setRegionCache(config); err != nil {
+		return err
+	}
+
+	config.regionCacheLoaded = true
+	return nil
+}
+
+func setRegionCache(config *ProviderConfig) error {
 	var regionList []*Region
 	var err error
-	if supportVPC {
-		regionList, err = getVpcRegionList(client)
+	if config.SupportVPC {
+		regionList, err = getVpcRegionList(config.Client)
 	} else {
-		regionList, err = getClassicRegionList(client)
+		regionList, err = getClassicRegionList(config.Client)
 	}
 
 	if err != nil {
@@ -102,11 +142,11 @@ func setRegionCache(client *NcloudAPIClient, supportVPC bool) error {
 			RegionCode: r.RegionCode,
 			RegionName: r.RegionName,
 		}
-		if !supportVPC {
+		if !config.SupportVPC {
 			region.RegionNo = r.RegionNo
 		}
 
-		regionCacheByCode[*region.RegionCode] = region
+		config.setRegionCache(region)
 	}
 
 	return nil
@@ -148,7 +188,7 @@ func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	return regionList, nil
 }
 
-func isValidRegionCode(code string) bool {
-	_, ok := regionCacheByCode[code]
+func isValidRegionCode(config *ProviderConfig, code string) bool {
+	_, ok := config.getRegionCache(code)
 	return ok
 }
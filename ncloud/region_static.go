@@ -0,0 +1,97 @@
+package ncloud
+
+import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+// staticRegion is a region of the built-in region table with its zones
+type staticRegion struct {
+	Region
+	Zones []Zone
+}
+
+func newStaticRegion(regionNo, regionCode, regionName string, zones ...Zone) staticRegion {
+	region := staticRegion{
+		Region: Region{
+			RegionCode: ncloud.String(regionCode),
+			RegionName: ncloud.String(regionName),
+		},
+		Zones: zones,
+	}
+	if regionNo != "" {
+		region.RegionNo = ncloud.String(regionNo)
+	}
+
+	return region
+}
+
+func newStaticZone(zoneNo, zoneCode string) Zone {
+	zone := Zone{
+		ZoneCode: ncloud.String(zoneCode),
+		ZoneName: ncloud.String(zoneCode),
+	}
+	if zoneNo != "" {
+		zone.ZoneNo = ncloud.String(zoneNo)
+	}
+
+	return zone
+}
+
+// staticRegions is the built-in table of the regions and zones of each site and platform.
+// It is used instead of GetRegionList when the provider is configured with `skip_region_validation` or `skip_credentials_validation`.
+// The numbers which are not listed are resolved from the API when a resource needs them.
+var staticRegions = map[string][]staticRegion{
+	"public/classic": {
+		newStaticRegion("1", "KR", "Korea", newStaticZone("2", "KR-1"), newStaticZone("3", "KR-2")),
+		newStaticRegion("", "HK", "HongKong", newStaticZone("", "HK-1")),
+		newStaticRegion("", "SGN", "Singapore", newStaticZone("", "SGN-1")),
+		newStaticRegion("", "USWN", "US-West(New)", newStaticZone("", "USWN-1")),
+		newStaticRegion("", "JPN", "Japan", newStaticZone("", "JPN-1")),
+		newStaticRegion("", "DEN", "Germany", newStaticZone("", "DEN-1")),
+	},
+	"public/vpc": {
+		newStaticRegion("", "KR", "Korea", newStaticZone("", "KR-1"), newStaticZone("", "KR-2")),
+		newStaticRegion("", "SGN", "Singapore", newStaticZone("", "SGN-4"), newStaticZone("", "SGN-5")),
+		newStaticRegion("", "JPN", "Japan", newStaticZone("", "JPN-4"), newStaticZone("", "JPN-5")),
+		newStaticRegion("", "USWN", "US-West(New)", newStaticZone("", "USWN-5")),
+		newStaticRegion("", "DEN", "Germany", newStaticZone("", "DEN-5")),
+	},
+	"gov/classic": {
+		newStaticRegion("", "KR", "Korea", newStaticZone("", "KR-1"), newStaticZone("", "KR-2")),
+	},
+	"gov/vpc": {
+		newStaticRegion("", "KR", "Korea", newStaticZone("", "KR-1"), newStaticZone("", "KR-2")),
+	},
+	"fin/vpc": {
+		newStaticRegion("", "FKR", "Korea", newStaticZone("", "FKR-1"), newStaticZone("", "FKR-2")),
+	},
+}
+
+// getStaticRegionList returns the built-in regions of the site and platform of the provider
+func getStaticRegionList(config *ProviderConfig) []staticRegion {
+	site := config.Site
+	if site == "" {
+		site = "public"
+	}
+
+	platform := "classic"
+	if config.SupportVPC {
+		platform = "vpc"
+	}
+
+	return staticRegions[site+"/"+platform]
+}
+
+// setStaticRegionCache fills the region and zone caches from the built-in table without calling the API
+func setStaticRegionCache(config *ProviderConfig) {
+	for _, r := range getStaticRegionList(config) {
+		config.setRegionCache(r.Region)
+
+		for _, zone := range r.Zones {
+			if zone.ZoneNo != nil {
+				config.setZoneCache(*zone.ZoneCode, *zone.ZoneNo)
+				config.setZoneCache(*zone.ZoneNo, *zone.ZoneCode)
+			}
+		}
+	}
+}
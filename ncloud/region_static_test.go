@@ -0,0 +1,98 @@
+package ncloud
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProviderConfigure_offline(t *testing.T) {
+	p := Provider()
+	raw := map[string]interface{}{
+		"access_key":                  "",
+		"secret_key":                  "",
+		"shared_credentials_file":     filepath.Join(t.TempDir(), "not-exist"),
+		"region":                      "SGN",
+		"skip_region_validation":      true,
+		"skip_credentials_validation": true,
+	}
+
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("Expected the provider to be configured without the API, got %v", diags)
+	}
+
+	config := p.Meta().(*ProviderConfig)
+	if config.RegionCode != "SGN" {
+		t.Fatalf("Expected: SGN, Actual: %s", config.RegionCode)
+	}
+
+	if _, err := getClassicRegionList(config.Client); err == nil || !strings.Contains(err.Error(), "no valid credentials found") {
+		t.Fatalf("Expected the API call to fail with the credentials error, got %v", err)
+	}
+}
+
+func TestProviderConfigure_skipCredentialsValidationUnknownRegion(t *testing.T) {
+	p := Provider()
+	raw := map[string]interface{}{
+		"access_key":                  "",
+		"secret_key":                  "",
+		"shared_credentials_file":     filepath.Join(t.TempDir(), "not-exist"),
+		"region":                      "XX",
+		"skip_credentials_validation": true,
+	}
+
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); !diags.HasError() {
+		t.Fatal("Unknown region code must throw error unless skip_region_validation is set")
+	}
+}
+
+func TestProviderConfigure_skipRegionValidation(t *testing.T) {
+	e := newTestEmulator(t)
+	_, config := testEmulatorProviderWithConfig(t, e, false, http.DefaultTransport, map[string]interface{}{
+		"skip_region_validation": true,
+	})
+
+	if n := e.callCount("server", "getRegionList"); n != 0 {
+		t.Fatalf("Expected no getRegionList call while configuring, got %d", n)
+	}
+	if config.RegionNo != "1" || getZoneNoByCode(config, "KR-2") != "3" {
+		t.Fatalf("Expected region and zone numbers of the built-in table, got %s", config.RegionNo)
+	}
+
+	if regionNo := getRegionNoByCode(config, "XX"); regionNo != nil {
+		t.Fatalf("Expected no region number for unknown region, got %s", ncloud.StringValue(regionNo))
+	}
+	getRegionNoByCode(config, "XX")
+	if n := e.callCount("server", "getRegionList"); n != 1 {
+		t.Fatalf("Expected the regions to be loaded once on the first miss, got %d calls", n)
+	}
+}
+
+func TestStaticRegions(t *testing.T) {
+	for key, regions := range staticRegions {
+		if len(regions) == 0 {
+			t.Errorf("%s: expected regions", key)
+		}
+		for _, r := range regions {
+			if ncloud.StringValue(r.RegionCode) == "" || len(r.Zones) == 0 {
+				t.Errorf("%s: expected region code and zones, got %#v", key, r)
+			}
+			for _, zone := range r.Zones {
+				if !strings.HasPrefix(ncloud.StringValue(zone.ZoneCode), ncloud.StringValue(r.RegionCode)+"-") {
+					t.Errorf("%s: zone %s doesn't belong to region %s", key, ncloud.StringValue(zone.ZoneCode), ncloud.StringValue(r.RegionCode))
+				}
+			}
+		}
+	}
+
+	config := &ProviderConfig{Site: "fin", SupportVPC: true}
+	setStaticRegionCache(config)
+	if !isValidRegionCode(config, "FKR") || isValidRegionCode(config, "KR") {
+		t.Fatal("Expected only FKR on fin site")
+	}
+}
@@ -0,0 +1,65 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testRegionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func testRegionProviderConfig(regionCode string, regionNo string) *ProviderConfig {
+	config := &ProviderConfig{
+		RegionCode: regionCode,
+		RegionNo:   regionNo,
+	}
+	config.setRegionCache(Region{RegionNo: ncloud.String(regionNo), RegionCode: ncloud.String(regionCode)})
+	config.regionCacheLoaded = true
+
+	return config
+}
+
+func TestParseRegionNoParameter_providerRegion(t *testing.T) {
+	krConfig := testRegionProviderConfig("KR", "1")
+	jpnConfig := testRegionProviderConfig("JPN", "7")
+	d := schema.TestResourceDataRaw(t, testRegionSchema(), map[string]interface{}{})
+
+	if regionNo, err := parseRegionNoParameter(krConfig, d); err != nil || ncloud.StringValue(regionNo) != "1" {
+		t.Fatalf("Expected region_no 1 for KR provider, got %s (err: %v)", ncloud.StringValue(regionNo), err)
+	}
+
+	if regionNo, err := parseRegionNoParameter(jpnConfig, d); err != nil || ncloud.StringValue(regionNo) != "7" {
+		t.Fatalf("Expected region_no 7 for JPN provider, got %s (err: %v)", ncloud.StringValue(regionNo), err)
+	}
+}
+
+func TestParseRegionNoParameter_unknownRegion(t *testing.T) {
+	krConfig := testRegionProviderConfig("KR", "1")
+	d := schema.TestResourceDataRaw(t, testRegionSchema(), map[string]interface{}{
+		"region": "JPN",
+	})
+
+	if regionNo, err := parseRegionNoParameter(krConfig, d); err == nil {
+		t.Fatalf("Unknown region code must throw error. region_no: %s", ncloud.StringValue(regionNo))
+	}
+}
+
+func TestIsValidRegionCode(t *testing.T) {
+	krConfig := testRegionProviderConfig("KR", "1")
+
+	if !isValidRegionCode(krConfig, "KR") {
+		t.Fatalf("KR should be valid region code")
+	}
+
+	if isValidRegionCode(krConfig, "JPN") {
+		t.Fatalf("JPN should not be valid region code of KR provider")
+	}
+}
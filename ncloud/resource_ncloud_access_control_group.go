@@ -1,13 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,11 +19,11 @@ func init() {
 
 func resourceNcloudAccessControlGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAccessControlGroupCreate,
-		Read:   resourceNcloudAccessControlGroupRead,
-		Delete: resourceNcloudAccessControlGroupDelete,
+		CreateContext: resourceNcloudAccessControlGroupCreate,
+		ReadContext:   resourceNcloudAccessControlGroupRead,
+		DeleteContext: resourceNcloudAccessControlGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -56,27 +57,27 @@ func resourceNcloudAccessControlGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudAccessControlGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := createAccessControlGroup(d, config)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*instance.AccessControlGroupNo)
 	log.Printf("[INFO] ACG ID: %s", d.Id())
 
-	return resourceNcloudAccessControlGroupRead(d, meta)
+	return resourceNcloudAccessControlGroupRead(ctx, d, meta)
 }
 
-func resourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getAccessControlGroup(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -94,11 +95,11 @@ func resourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceNcloudAccessControlGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	if err := deleteAccessControlGroup(config, d.Id()); err != nil {
-		return err
+	if err := deleteAccessControlGroup(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -160,15 +161,15 @@ func createVpcAccessControlGroup(d *schema.ResourceData, config *ProviderConfig)
 	return resp.AccessControlGroupList[0], nil
 }
 
-func deleteAccessControlGroup(config *ProviderConfig, id string) error {
+func deleteAccessControlGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcAccessControlGroup(config, id)
+		return deleteVpcAccessControlGroup(ctx, config, id)
 	}
 
 	return NotSupportClassic("resource `ncloud_access_control_group`")
 }
 
-func deleteVpcAccessControlGroup(config *ProviderConfig, id string) error {
+func deleteVpcAccessControlGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	accessControlGroup, err := getAccessControlGroup(config, id)
 	if err != nil {
 		return err
@@ -192,14 +193,14 @@ func deleteVpcAccessControlGroup(config *ProviderConfig, id string) error {
 	}
 	logResponse("deleteVpcAccessControlGroup", resp)
 
-	if err := waitForVpcAccessControlGroupDeletion(config, id); err != nil {
+	if err := waitForVpcAccessControlGroupDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForVpcAccessControlGroupDeletion(config *ProviderConfig, id string) error {
+func waitForVpcAccessControlGroupDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN"},
 		Target:  []string{"TERMINATED"},
@@ -208,11 +209,11 @@ func waitForVpcAccessControlGroupDeletion(config *ProviderConfig, id string) err
 			return VpcCommonStateRefreshFunc(instance, err, "AccessControlGroupStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Access Control Group (%s) to become terminated: %s", id, err)
 	}
@@ -220,7 +221,7 @@ func waitForVpcAccessControlGroupDeletion(config *ProviderConfig, id string) err
 	return nil
 }
 
-func waitForVpcAccessControlGroupRunning(config *ProviderConfig, id string) error {
+func waitForVpcAccessControlGroupRunning(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
@@ -229,11 +230,11 @@ func waitForVpcAccessControlGroupRunning(config *ProviderConfig, id string) erro
 			return VpcCommonStateRefreshFunc(instance, err, "AccessControlGroupStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Access Control Group (%s) to become running: %s", id, err)
 	}
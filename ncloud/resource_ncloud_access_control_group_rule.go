@@ -1,9 +1,11 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +19,10 @@ func init() {
 
 func resourceNcloudAccessControlGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAccessControlGroupRuleCreate,
-		Read:   resourceNcloudAccessControlGroupRuleRead,
-		Update: resourceNcloudAccessControlGroupRuleUpdate,
-		Delete: resourceNcloudAccessControlGroupRuleDelete,
+		CreateContext: resourceNcloudAccessControlGroupRuleCreate,
+		ReadContext:   resourceNcloudAccessControlGroupRuleRead,
+		UpdateContext: resourceNcloudAccessControlGroupRuleUpdate,
+		DeleteContext: resourceNcloudAccessControlGroupRuleDelete,
 		Schema: map[string]*schema.Schema{
 			"access_control_group_no": {
 				Type:     schema.TypeString,
@@ -110,20 +112,20 @@ func resourceNcloudAccessControlGroupRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudAccessControlGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_access_control_group_rule`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_access_control_group_rule`"))
 	}
 
 	d.SetId(d.Get("access_control_group_no").(string))
 	log.Printf("[INFO] ACG ID: %s", d.Id())
 
-	return resourceNcloudAccessControlGroupRuleUpdate(d, meta)
+	return resourceNcloudAccessControlGroupRuleUpdate(ctx, d, meta)
 }
 
-func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	rules, err := getAccessControlGroupRuleList(config, d.Id())
@@ -133,7 +135,7 @@ func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta inter
 		if errBody.ReturnCode == "1007000" { // Acg was not found
 			d.SetId("")
 		}
-		return err
+		return diagFromErr(err)
 	}
 
 	if len(rules) == 0 {
@@ -178,48 +180,48 @@ func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceNcloudAccessControlGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("inbound") {
-		if err := updateAccessControlGroupRule(d, config, "inbound"); err != nil {
-			return err
+		if err := updateAccessControlGroupRule(ctx, d, config, "inbound"); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("outbound") {
-		if err := updateAccessControlGroupRule(d, config, "outbound"); err != nil {
-			return err
+		if err := updateAccessControlGroupRule(ctx, d, config, "outbound"); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudAccessControlGroupRuleRead(d, meta)
+	return resourceNcloudAccessControlGroupRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudAccessControlGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	accessControlGroup, err := getAccessControlGroup(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if accessControlGroup == nil {
-		return fmt.Errorf("no matching Access Control Group: %s", d.Id())
+		return diagFromErr(fmt.Errorf("no matching Access Control Group: %s", d.Id()))
 	}
 
 	i := d.Get("inbound").(*schema.Set)
 	o := d.Get("outbound").(*schema.Set)
 
 	if len(i.List()) > 0 {
-		if err := removeAccessControlGroupRule(d, config, "inbound", accessControlGroup, expandRemoveAccessControlGroupRule(i.List())); err != nil {
-			return err
+		if err := removeAccessControlGroupRule(ctx, d, config, "inbound", accessControlGroup, expandRemoveAccessControlGroupRule(i.List())); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if len(o.List()) > 0 {
-		if err := removeAccessControlGroupRule(d, config, "outbound", accessControlGroup, expandRemoveAccessControlGroupRule(o.List())); err != nil {
-			return err
+		if err := removeAccessControlGroupRule(ctx, d, config, "outbound", accessControlGroup, expandRemoveAccessControlGroupRule(o.List())); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -243,7 +245,7 @@ func getAccessControlGroupRuleList(config *ProviderConfig, id string) ([]*vserve
 	return resp.AccessControlGroupRuleList, nil
 }
 
-func updateAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig, ruleType string) error {
+func updateAccessControlGroupRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string) error {
 	o, n := d.GetChange(ruleType)
 
 	if o == nil {
@@ -275,13 +277,13 @@ func updateAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig
 	}
 
 	if len(removeAccessControlGroupRuleList) > 0 {
-		if err := removeAccessControlGroupRule(d, config, ruleType, accessControlGroup, removeAccessControlGroupRuleList); err != nil {
+		if err := removeAccessControlGroupRule(ctx, d, config, ruleType, accessControlGroup, removeAccessControlGroupRuleList); err != nil {
 			return err
 		}
 	}
 
 	if len(addAccessControlGroupRuleList) > 0 {
-		if err := addAccessControlGroupRule(d, config, ruleType, accessControlGroup, addAccessControlGroupRuleList); err != nil {
+		if err := addAccessControlGroupRule(ctx, d, config, ruleType, accessControlGroup, addAccessControlGroupRuleList); err != nil {
 			return err
 		}
 	}
@@ -289,11 +291,11 @@ func updateAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig
 	return nil
 }
 
-func addAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.AddAccessControlGroupRuleParameter) error {
+func addAccessControlGroupRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.AddAccessControlGroupRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
 		var reqParams interface{}
@@ -323,7 +325,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig, r
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == ApiErrorAcgCantChangeSameTime {
 				logErrorResponse("retry AddAccessControlGroupRule", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -338,18 +340,18 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig, r
 
 	logResponse("AddAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.RemoveAccessControlGroupRuleParameter) error {
+func removeAccessControlGroupRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string, accessControlGroup *vserver.AccessControlGroup, accessControlGroupRule []*vserver.RemoveAccessControlGroupRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		var reqParams interface{}
@@ -379,7 +381,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == ApiErrorAcgCantChangeSameTime {
 				logErrorResponse("retry RemoveAccessControlGroupRule", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -394,7 +396,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *ProviderConfig
 
 	logResponse("RemoveAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
@@ -128,6 +129,6 @@ func testAccCheckAccessControlGroupDestroy(s *terraform.State) error {
 func testAccCheckAccessControlGroupDisappears(instance *vserver.AccessControlGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*ProviderConfig)
-		return deleteAccessControlGroup(config, *instance.AccessControlGroupNo)
+		return deleteAccessControlGroup(context.Background(), config, *instance.AccessControlGroupNo)
 	}
 }
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,13 +21,17 @@ func init() {
 
 func resourceNcloudAutoScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingGroupCreate,
-		Read:   resourceNcloudAutoScalingGroupRead,
-		Update: resourceNcloudAutoScalingGroupUpdate,
-		Delete: resourceNcloudAutoScalingGroupDelete,
+		CreateContext: resourceNcloudAutoScalingGroupCreate,
+		ReadContext:   resourceNcloudAutoScalingGroupRead,
+		UpdateContext: resourceNcloudAutoScalingGroupUpdate,
+		DeleteContext: resourceNcloudAutoScalingGroupDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(DefaultStopTimeout*3 + DefaultTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_no": {
 				Type:     schema.TypeString,
@@ -122,20 +128,20 @@ func resourceNcloudAutoScalingGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	id, err := createAutoScalingGroup(d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	if err := waitForAutoScalingGroupCapacity(d, config); err != nil {
-		return err
+	if err := waitForAutoScalingGroupCapacity(ctx, d, config); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudAutoScalingGroupRead(d, meta)
+	return resourceNcloudAutoScalingGroupRead(ctx, d, meta)
 }
 
 func createAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -222,12 +228,12 @@ func createClassicAutoScalingGroup(d *schema.ResourceData, config *ProviderConfi
 	return resp.AutoScalingGroupList[0].AutoScalingGroupNo, nil
 }
 
-func resourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	autoScalingGroup, err := getAutoScalingGroup(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if autoScalingGroup == nil {
@@ -254,19 +260,28 @@ func getVpcAutoScalingGroup(config *ProviderConfig, id string) (*AutoScalingGrou
 		AutoScalingGroupNoList: []*string{ncloud.String(id)},
 	}
 
-	logCommonRequest("getVpcAutoScalingGroup", reqParams)
-	resp, err := config.Client.vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*vautoscaling.AutoScalingGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcAutoScalingGroup", reqParams)
+		resp, err := config.Client.vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcAutoScalingGroup", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcAutoScalingGroup", resp)
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcAutoScalingGroup", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcAutoScalingGroup", resp)
 
-	if len(resp.AutoScalingGroupList) < 1 {
+	if len(autoScalingGroupList) < 1 {
 		return nil, nil
 	}
 
-	asg := resp.AutoScalingGroupList[0]
+	asg := autoScalingGroupList[0]
 
 	return &AutoScalingGroup{
 		AutoScalingGroupNo:                   asg.AutoScalingGroupNo,
@@ -291,18 +306,27 @@ func getVpcAutoScalingGroup(config *ProviderConfig, id string) (*AutoScalingGrou
 func getClassicAutoScalingGroup(config *ProviderConfig, id string) (*AutoScalingGroup, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: providerRegionNo(config),
 	}
 
-	logCommonRequest("getClassicAutoScalingGroup", reqParams)
-	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*autoscaling.AutoScalingGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicAutoScalingGroup", reqParams)
+		resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicAutoScalingGroup", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicAutoScalingGroup", resp)
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicAutoScalingGroup", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicAutoScalingGroup", resp)
 
-	for _, a := range resp.AutoScalingGroupList {
+	for _, a := range autoScalingGroupList {
 		if *a.AutoScalingGroupNo == *no {
 			return &AutoScalingGroup{
 				AutoScalingGroupNo:                   a.AutoScalingGroupNo,
@@ -325,13 +349,13 @@ func getClassicAutoScalingGroup(config *ProviderConfig, id string) (*AutoScaling
 	return nil, nil
 }
 
-func resourceNcloudAutoScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if err := updateAutoScalingGroup(d, config); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudAutoScalingGroupRead(d, config)
+	return resourceNcloudAutoScalingGroupRead(ctx, d, config)
 }
 
 func updateAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) error {
@@ -364,7 +388,7 @@ func changeVpcAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) e
 		min := ncloud.Int32(int32(d.Get("min_size").(int)))
 		max := ncloud.Int32(int32(d.Get("max_size").(int)))
 		if *min > *max {
-			return fmt.Errorf("min_size is must be at least 0 and less than or equal to max_size")
+			return &attributeError{Attribute: "min_size", Err: fmt.Errorf("min_size is must be at least 0 and less than or equal to max_size")}
 		}
 		reqParams.MinSize = min
 		reqParams.MaxSize = max
@@ -421,7 +445,7 @@ func changeClassicAutoScalingGroup(d *schema.ResourceData, config *ProviderConfi
 		min := ncloud.Int32(int32(d.Get("min_size").(int)))
 		max := ncloud.Int32(int32(d.Get("max_size").(int)))
 		if *min > *max {
-			return fmt.Errorf("min_size is must be at least 0 and less than or equal to max_size")
+			return &attributeError{Attribute: "min_size", Err: fmt.Errorf("min_size is must be at least 0 and less than or equal to max_size")}
 		}
 		reqParams.MinSize = min
 		reqParams.MaxSize = max
@@ -452,24 +476,23 @@ func changeClassicAutoScalingGroup(d *schema.ResourceData, config *ProviderConfi
 	return nil
 }
 
-func resourceNcloudAutoScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
-	if err := deleteAutoScalingGroup(d, config); err != nil {
-		return err
+	if err := deleteAutoScalingGroup(ctx, d, config); err != nil {
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func deleteAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) error {
-	d.Timeout(schema.TimeoutDelete)
+func deleteAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	if config.SupportVPC {
-		return deleteVpcAutoScalingGroup(config, d.Id())
+		return deleteVpcAutoScalingGroup(ctx, config, d.Id())
 	} else {
-		return deleteClassicAutoScalingGroup(config, d.Id())
+		return deleteClassicAutoScalingGroup(ctx, config, d.Id())
 	}
 }
 
-func deleteVpcAutoScalingGroup(config *ProviderConfig, id string) error {
+func deleteVpcAutoScalingGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	asg, err := getAutoScalingGroup(config, id)
 	if err != nil {
 		return err
@@ -487,18 +510,18 @@ func deleteVpcAutoScalingGroup(config *ProviderConfig, id string) error {
 		return err
 	}
 
-	if err := waitForVpcInAutoScalingGroupServerInstanceListDeletion(config, id); err != nil {
+	if err := waitForVpcInAutoScalingGroupServerInstanceListDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
-	if err := waitForVpcAutoScalingGroupDeletion(config, id); err != nil {
+	if err := waitForVpcAutoScalingGroupDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func deleteClassicAutoScalingGroup(config *ProviderConfig, id string) error {
+func deleteClassicAutoScalingGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	asg, err := getAutoScalingGroup(config, id)
 	if err != nil {
 		return err
@@ -517,12 +540,12 @@ func deleteClassicAutoScalingGroup(config *ProviderConfig, id string) error {
 	}
 
 	// 2. Delete Server Instance List in AutoScalingGroup
-	if err := waitForClassicInAutoScalingGroupServerInstanceListDeletion(config, id); err != nil {
+	if err := waitForClassicInAutoScalingGroupServerInstanceListDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	// 3. Delete Auto Scaling Group
-	if err := waitForClassicAutoScalingGroupDeletion(config, ncloud.StringValue(asg.AutoScalingGroupName)); err != nil {
+	if err := waitForClassicAutoScalingGroupDeletion(ctx, config, ncloud.StringValue(asg.AutoScalingGroupName)); err != nil {
 		return err
 	}
 
@@ -535,12 +558,21 @@ func getVpcInAutoScalingGroupServerInstanceList(config *ProviderConfig, id strin
 		AutoScalingGroupNoList: []*string{ncloud.String(id)},
 	}
 
-	resp, err := config.Client.vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*vautoscaling.AutoScalingGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vautoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	asg := resp.AutoScalingGroupList[0]
+	asg := autoScalingGroupList[0]
 	list := make([]*InAutoScalingGroupServerInstance, 0)
 	for _, i := range asg.InAutoScalingGroupServerInstanceList {
 		list = append(list, &InAutoScalingGroupServerInstance{
@@ -560,15 +592,24 @@ func getClassicInAutoScalingGroupServerInstanceList(config *ProviderConfig, id s
 
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
 		AutoScalingGroupNameList: []*string{tmpAsg.AutoScalingGroupName},
-		RegionNo:                 &config.RegionNo,
+		RegionNo:                 providerRegionNo(config),
 	}
 
-	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*autoscaling.AutoScalingGroup
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	asg := resp.AutoScalingGroupList[0]
+	asg := autoScalingGroupList[0]
 	list := make([]*InAutoScalingGroupServerInstance, 0)
 	for _, i := range asg.InAutoScalingGroupServerInstanceList {
 		list = append(list, &InAutoScalingGroupServerInstance{
@@ -580,10 +621,12 @@ func getClassicInAutoScalingGroupServerInstanceList(config *ProviderConfig, id s
 	return list, nil
 }
 
-func waitForClassicInAutoScalingGroupServerInstanceListDeletion(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForClassicInAutoScalingGroupServerInstanceListDeletion(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &statusWaiter{
+		Name:    "InAutoScalingGroupServerInstanceList",
+		ID:      id,
 		Pending: []string{"INSVC"},
-		Target:  []string{"TERMT"},
+		Target:  []string{InstanceStatusTerminate},
 		Refresh: func() (interface{}, string, error) {
 			asg, err := getAutoScalingGroup(config, id)
 			if err != nil {
@@ -592,24 +635,21 @@ func waitForClassicInAutoScalingGroupServerInstanceListDeletion(config *Provider
 			if len(asg.InAutoScalingGroupServerInstanceList) > 0 {
 				return asg, "INSVC", nil
 			} else {
-				return asg, "TERMT", nil
+				return asg, InstanceStatusTerminate, nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    DefaultStopTimeout * 3,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for InAutoScalingGroupServerInstanceList (%s) to become deleting: %s", id, err)
-	}
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForVpcInAutoScalingGroupServerInstanceListDeletion(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcInAutoScalingGroupServerInstanceListDeletion(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &statusWaiter{
+		Name:    "InAutoScalingGroupServerInstanceList",
+		ID:      id,
 		Pending: []string{"INSVC"},
-		Target:  []string{"TERMT"},
+		Target:  []string{InstanceStatusTerminate},
 		Refresh: func() (interface{}, string, error) {
 			asg, err := getAutoScalingGroup(config, id)
 			if err != nil {
@@ -618,22 +658,20 @@ func waitForVpcInAutoScalingGroupServerInstanceListDeletion(config *ProviderConf
 			if len(asg.InAutoScalingGroupServerInstanceList) > 0 {
 				return asg, "INSVC", nil
 			} else {
-				return asg, "TERMT", nil
+				return asg, InstanceStatusTerminate, nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    DefaultStopTimeout * 3,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for InAutoScalingGroupServerInstanceList (%s) to become deleting: %s", id, err)
-	}
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForClassicAutoScalingGroupDeletion(config *ProviderConfig, name string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForClassicAutoScalingGroupDeletion(ctx context.Context, config *ProviderConfig, name string) error {
+	waiter := &statusWaiter{
+		Name:    "AutoScalingGroup",
+		ID:      name,
+		Field:   "deletion",
 		Pending: []string{"RUN"},
 		Target:  []string{"DELETE"},
 		Refresh: func() (interface{}, string, error) {
@@ -653,19 +691,17 @@ func waitForClassicAutoScalingGroupDeletion(config *ProviderConfig, name string)
 				return resp, "DELETE", nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    DefaultTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for AutoScalingGroup (%s) to become deleting: %s", name, err)
-	}
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForVpcAutoScalingGroupDeletion(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcAutoScalingGroupDeletion(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &statusWaiter{
+		Name:    "AutoScalingGroup",
+		ID:      id,
+		Field:   "deletion",
 		Pending: []string{"RUN"},
 		Target:  []string{"DELETE"},
 		Refresh: func() (interface{}, string, error) {
@@ -685,18 +721,13 @@ func waitForVpcAutoScalingGroupDeletion(config *ProviderConfig, id string) error
 				return resp, "DELETE", nil
 			}
 		},
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Timeout:    DefaultTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for AutoScalingGroup (%s) to become deleting: %s", id, err)
-	}
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderConfig) error {
+func waitForAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	wait, err := time.ParseDuration(d.Get("wait_for_capacity_timeout").(string))
 	if err != nil {
 		return err
@@ -707,14 +738,14 @@ func waitForAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderCon
 	}
 
 	if config.SupportVPC {
-		return waitForVpcAutoScalingGroupCapacity(d, config, wait)
+		return waitForVpcAutoScalingGroupCapacity(ctx, d, config, wait)
 	} else {
-		return waitForClassicAutoScalingGroupCapacity(d, config, wait)
+		return waitForClassicAutoScalingGroupCapacity(ctx, d, config, wait)
 	}
 }
 
-func waitForVpcAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
-	return resource.Retry(wait, func() *resource.RetryError {
+func waitForVpcAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
+	return resource.RetryContext(ctx, wait, func() *resource.RetryError {
 		asg, err := getVpcAutoScalingGroup(config, d.Id())
 		asgServerInstanceList, err := getVpcInAutoScalingGroupServerInstanceList(config, d.Id())
 		if err != nil {
@@ -746,8 +777,8 @@ func waitForVpcAutoScalingGroupCapacity(d *schema.ResourceData, config *Provider
 	})
 }
 
-func waitForClassicAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
-	return resource.Retry(wait, func() *resource.RetryError {
+func waitForClassicAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
+	return resource.RetryContext(ctx, wait, func() *resource.RetryError {
 		asg, err := getClassicAutoScalingGroup(config, d.Id())
 		asgServerInstanceList, err := getClassicInAutoScalingGroupServerInstanceList(config, d.Id())
 		if err != nil {
@@ -784,15 +815,24 @@ func getClassicAutoScalingGroupByName(config *ProviderConfig, name string) (*Aut
 		RegionNo:                 &config.RegionCode,
 		AutoScalingGroupNameList: []*string{ncloud.String(name)},
 	}
-	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+	var autoScalingGroupList []*autoscaling.AutoScalingGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		autoScalingGroupList = append(autoScalingGroupList, resp.AutoScalingGroupList...)
+		return len(resp.AutoScalingGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if len(resp.AutoScalingGroupList) < 1 {
+	if len(autoScalingGroupList) < 1 {
 		return nil, nil
 	}
 
-	a := resp.AutoScalingGroupList[0]
+	a := autoScalingGroupList[0]
 	return &AutoScalingGroup{
 		AutoScalingGroupNo:                   a.AutoScalingGroupNo,
 		AutoScalingGroupName:                 a.AutoScalingGroupName,
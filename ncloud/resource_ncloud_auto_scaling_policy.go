@@ -1,9 +1,11 @@
 package ncloud
 
 import (
+	"context"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,10 +16,10 @@ func init() {
 
 func resourceNcloudAutoScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingPolicyCreate,
-		Read:   resourceNcloudAutoScalingPolicyRead,
-		Update: resourceNcloudAutoScalingPolicyUpdate,
-		Delete: resourceNcloudAutoScalingPolicyDelete,
+		CreateContext: resourceNcloudAutoScalingPolicyCreate,
+		ReadContext:   resourceNcloudAutoScalingPolicyRead,
+		UpdateContext: resourceNcloudAutoScalingPolicyUpdate,
+		DeleteContext: resourceNcloudAutoScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,17 +56,17 @@ func resourceNcloudAutoScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	autoscaling_group_no, id, err := createAutoScalingPolicy(d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	d.Set("auto_scaling_group_no", autoscaling_group_no)
-	return resourceNcloudAutoScalingPolicyRead(d, meta)
+	return resourceNcloudAutoScalingPolicyRead(ctx, d, meta)
 }
 
 func createAutoScalingPolicy(d *schema.ResourceData, config *ProviderConfig) (*string, *string, error) {
@@ -121,11 +123,11 @@ func createClassicAutoScalingPolicy(d *schema.ResourceData, config *ProviderConf
 	return ncloud.String(no), name, nil
 }
 
-func resourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	policy, err := getAutoScalingPolicy(config, d.Id(), d.Get("auto_scaling_group_no").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if policy == nil {
@@ -183,15 +185,24 @@ func getClassicAutoScalingPolicy(config *ProviderConfig, id string, autoScalingG
 		PolicyNameList:       []*string{ncloud.String(id)},
 		AutoScalingGroupName: asg.AutoScalingGroupName,
 	}
-	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
+	var scalingPolicyList []*autoscaling.ScalingPolicy
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetAutoScalingPolicyList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		scalingPolicyList = append(scalingPolicyList, resp.ScalingPolicyList...)
+		return len(resp.ScalingPolicyList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if len(resp.ScalingPolicyList) < 1 {
+	if len(scalingPolicyList) < 1 {
 		return nil, nil
 	}
 
-	p := resp.ScalingPolicyList[0]
+	p := scalingPolicyList[0]
 	return &AutoScalingPolicy{
 		AutoScalingPolicyName: p.PolicyName,
 		AdjustmentTypeCode:    p.AdjustmentType.Code,
@@ -202,19 +213,19 @@ func getClassicAutoScalingPolicy(config *ProviderConfig, id string, autoScalingG
 	}, nil
 }
 
-func resourceNcloudAutoScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	_, _, err := createAutoScalingPolicy(d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceNcloudAutoScalingPolicyRead(d, meta)
+	return resourceNcloudAutoScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceNcloudAutoScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if err := deleteAutoScalingPolicy(config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
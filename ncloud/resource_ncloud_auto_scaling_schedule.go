@@ -1,9 +1,11 @@
 package ncloud
 
 import (
+	"context"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,10 +17,10 @@ const SCHEDULE_TIME_FORMAT = "2006-01-02T15:04:05Z0700"
 
 func resourceNcloudAutoScalingSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingScheduleCreate,
-		Read:   resourceNcloudAutoScalingScheduleRead,
-		Update: resourceNcloudAutoScalingScheduleUpdate,
-		Delete: resourceNcloudAutoScalingScheduleDelete,
+		CreateContext: resourceNcloudAutoScalingScheduleCreate,
+		ReadContext:   resourceNcloudAutoScalingScheduleRead,
+		UpdateContext: resourceNcloudAutoScalingScheduleUpdate,
+		DeleteContext: resourceNcloudAutoScalingScheduleDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,15 +68,15 @@ func resourceNcloudAutoScalingSchedule() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	id, err := createAutoScalingSchedule(d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	return resourceNcloudAutoScalingScheduleRead(d, meta)
+	return resourceNcloudAutoScalingScheduleRead(ctx, d, meta)
 }
 
 func createAutoScalingSchedule(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -135,11 +137,11 @@ func createClassicAutoScalingSchedule(d *schema.ResourceData, config *ProviderCo
 	return resp.ScheduledUpdateGroupActionList[0].ScheduledActionName, nil
 }
 
-func resourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	schedule, err := getAutoScalingSchedule(config, d.Id(), d.Get("auto_scaling_group_no").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if schedule == nil {
@@ -166,16 +168,25 @@ func getVpcAutoScalingSchedule(config *ProviderConfig, id string, asgNo string)
 		AutoScalingGroupNo:      ncloud.String(asgNo),
 		ScheduledActionNameList: []*string{ncloud.String(id)},
 	}
-	resp, err := config.Client.vautoscaling.V2Api.GetScheduledActionList(reqParams)
+	var scheduledUpdateGroupActionList []*vautoscaling.ScheduledUpdateGroupAction
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vautoscaling.V2Api.GetScheduledActionList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		scheduledUpdateGroupActionList = append(scheduledUpdateGroupActionList, resp.ScheduledUpdateGroupActionList...)
+		return len(resp.ScheduledUpdateGroupActionList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.ScheduledUpdateGroupActionList) < 1 {
+	if len(scheduledUpdateGroupActionList) < 1 {
 		return nil, nil
 	}
 
-	s := resp.ScheduledUpdateGroupActionList[0]
+	s := scheduledUpdateGroupActionList[0]
 	return &AutoScalingSchedule{
 		ScheduledActionNo:   s.ScheduledActionNo,
 		ScheduledActionName: s.ScheduledActionName,
@@ -199,16 +210,25 @@ func getClassicAutoScalingSchedule(config *ProviderConfig, id string, asgNo stri
 		AutoScalingGroupName:    asg.AutoScalingGroupName,
 		ScheduledActionNameList: []*string{ncloud.String(id)},
 	}
-	resp, err := config.Client.autoscaling.V2Api.GetScheduledActionList(reqParams)
+	var scheduledUpdateGroupActionList []*autoscaling.ScheduledUpdateGroupAction
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetScheduledActionList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		scheduledUpdateGroupActionList = append(scheduledUpdateGroupActionList, resp.ScheduledUpdateGroupActionList...)
+		return len(resp.ScheduledUpdateGroupActionList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.ScheduledUpdateGroupActionList) < 1 {
+	if len(scheduledUpdateGroupActionList) < 1 {
 		return nil, nil
 	}
 
-	s := resp.ScheduledUpdateGroupActionList[0]
+	s := scheduledUpdateGroupActionList[0]
 	return &AutoScalingSchedule{
 		AutoScalingGroupNo:  asg.AutoScalingGroupNo,
 		ScheduledActionName: s.ScheduledActionName,
@@ -221,18 +241,18 @@ func getClassicAutoScalingSchedule(config *ProviderConfig, id string, asgNo stri
 	}, nil
 }
 
-func resourceNcloudAutoScalingScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if _, err := createAutoScalingSchedule(d, config); err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceNcloudAutoScalingScheduleRead(d, meta)
+	return resourceNcloudAutoScalingScheduleRead(ctx, d, meta)
 }
 
-func resourceNcloudAutoScalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if err := deleteAutoScalingSchedule(config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,23 +29,25 @@ const (
 
 func resourceNcloudBlockStorage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudBlockStorageCreate,
-		Read:   resourceNcloudBlockStorageRead,
-		Update: resourceNcloudBlockStorageUpdate,
-		Delete: resourceNcloudBlockStorageDelete,
+		CreateContext: resourceNcloudBlockStorageCreate,
+		ReadContext:   resourceNcloudBlockStorageRead,
+		UpdateContext: resourceNcloudBlockStorageUpdate,
+		DeleteContext: resourceNcloudBlockStorageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(DefaultUpdateTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"server_instance_no": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"size": {
 				Type:             schema.TypeInt,
@@ -110,26 +114,26 @@ func resourceNcloudBlockStorage() *schema.Resource {
 	}
 }
 
-func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	id, err := createBlockStorage(d, config)
+	id, err := createBlockStorage(ctx, d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Block Storage ID: %s", d.Id())
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	return resourceNcloudBlockStorageRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	r, err := getBlockStorage(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if r == nil {
@@ -144,31 +148,31 @@ func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceNcloudBlockStorageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	if err := deleteBlockStorage(d, config, d.Id()); err != nil {
-		return err
+	if err := deleteBlockStorage(ctx, d, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
+			if err := detachBlockStorage(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+			if err := attachBlockStorage(ctx, config, d.Id(), n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -177,31 +181,31 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 		o, n := d.GetChange("size")
 
 		if o.(int) >= n.(int) {
-			return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
+			return diagFromErr(&attributeError{Attribute: "size", Err: fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)})
 		}
 
 		// If server instance attached block storage, detach first
 		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
+			if err := detachBlockStorage(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 
-		if err := changeBlockStorageSize(d, config); err != nil {
-			return err
+		if err := changeBlockStorageSize(ctx, d, config); err != nil {
+			return diagFromErr(err)
 		}
 
 		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+			if err := attachBlockStorage(ctx, config, d.Id(), d.Get("server_instance_no").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	return resourceNcloudBlockStorageRead(ctx, d, meta)
 }
 
-func createBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createBlockStorage(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	var id *string
 	var err error
 
@@ -215,7 +219,15 @@ func createBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*string
 		return nil, err
 	}
 
-	if err := waitForBlockStorageAttachment(config, *id); err != nil {
+	if _, ok := d.GetOk("server_instance_no"); !ok {
+		if err := waitForBlockStorageCreation(ctx, config, *id, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return nil, err
+		}
+
+		return id, nil
+	}
+
+	if err := waitForBlockStorageAttachment(ctx, config, *id, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return nil, err
 	}
 
@@ -223,6 +235,10 @@ func createBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*string
 }
 
 func createClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+	if _, ok := d.GetOk("server_instance_no"); !ok {
+		return nil, fmt.Errorf("`server_instance_no` is required on classic. block storage can only be created unattached on vpc")
+	}
+
 	reqParams := &server.CreateBlockStorageInstanceRequest{
 		ServerInstanceNo:        ncloud.String(d.Get("server_instance_no").(string)),
 		BlockStorageSize:        ncloud.Int64(int64(d.Get("size").(int))),
@@ -249,7 +265,7 @@ func createVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*str
 	reqParams := &vserver.CreateBlockStorageInstanceRequest{
 		RegionCode:                     &config.RegionCode,
 		BlockStorageSize:               ncloud.Int32(int32(d.Get("size").(int))),
-		ServerInstanceNo:               ncloud.String(d.Get("server_instance_no").(string)),
+		ServerInstanceNo:               StringPtrOrNil(d.GetOk("server_instance_no")),
 		BlockStorageName:               StringPtrOrNil(d.GetOk("name")),
 		BlockStorageDescription:        StringPtrOrNil(d.GetOk("description")),
 		BlockStorageDiskDetailTypeCode: StringPtrOrNil(d.GetOk("disk_detail_type")),
@@ -284,17 +300,25 @@ func getClassicBlockStorage(config *ProviderConfig, id string) (*BlockStorage, e
 		BlockStorageInstanceNoList: ncloud.StringList([]string{id}),
 	}
 
-	logCommonRequest("getClassicBlockStorage", reqParams)
+	var blockStorageInstanceList []*server.BlockStorageInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicBlockStorage", reqParams)
+		resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicBlockStorage", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicBlockStorage", resp)
 
-	resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(reqParams)
+		blockStorageInstanceList = append(blockStorageInstanceList, resp.BlockStorageInstanceList...)
+		return len(resp.BlockStorageInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicBlockStorage", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicBlockStorage", resp)
 
-	if len(resp.BlockStorageInstanceList) > 0 {
-		inst := resp.BlockStorageInstanceList[0]
+	if len(blockStorageInstanceList) > 0 {
+		inst := blockStorageInstanceList[0]
 
 		return &BlockStorage{
 			BlockStorageInstanceNo:  inst.BlockStorageInstanceNo,
@@ -354,51 +378,28 @@ func getVpcBlockStorage(config *ProviderConfig, id string) (*BlockStorage, error
 	return nil, nil
 }
 
-func deleteBlockStorage(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteBlockStorage(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	var err error
 	if config.SupportVPC {
-		err = deleteVpcBlockStorage(d, config, id)
+		err = deleteVpcBlockStorage(ctx, d, config, id)
 	} else {
-		err = deleteClassicBlockStorage(d, config, id)
+		err = deleteClassicBlockStorage(ctx, d, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeAttach},
-		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getBlockStorage(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-			if instance == nil { // Instance is terminated.
-				return instance, "TERMINATED", nil
-			}
-			return instance, ncloud.StringValue(instance.Status), nil
-		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"TERMINATED\": %s", err)
-	}
-
-	return nil
+	return waitForBlockStorageStatus(ctx, config, id, []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeAttach}, []string{InstanceStatusTerminated}, d.Timeout(schema.TimeoutDelete))
 }
 
-func deleteClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteClassicBlockStorage(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	reqParams := server.DeleteBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
 
 	var resp *server.DeleteBlockStorageInstancesResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		logCommonRequest("deleteClassicBlockStorage", reqParams)
@@ -407,7 +408,7 @@ func deleteClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig, i
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == ApiErrorDetachingMountedStorage {
 				logErrorResponse("retry deleteClassicBlockStorage", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -424,13 +425,13 @@ func deleteClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig, i
 	return nil
 }
 
-func deleteVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteVpcBlockStorage(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	reqParams := vserver.DeleteBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
 
 	var resp *vserver.DeleteBlockStorageInstancesResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		logCommonRequest("deleteVpcBlockStorage", reqParams)
@@ -439,7 +440,7 @@ func deleteVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig, id st
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == ApiErrorDetachingMountedStorage {
 				logErrorResponse("retry deleteVpcBlockStorage", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -456,7 +457,7 @@ func deleteVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig, id st
 	return nil
 }
 
-func detachBlockStorage(config *ProviderConfig, id string) error {
+func detachBlockStorage(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
 	var err error
 	if config.SupportVPC {
 		err = detachVpcBlockStorage(config, id)
@@ -468,7 +469,7 @@ func detachBlockStorage(config *ProviderConfig, id string) error {
 		return err
 	}
 
-	if err = waitForBlockStorageDetachment(config, id); err != nil {
+	if err = waitForBlockStorageDetachment(ctx, config, id, timeout); err != nil {
 		return err
 	}
 
@@ -509,53 +510,33 @@ func detachVpcBlockStorage(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func waitForBlockStorageDetachment(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{BlockStorageStatusCodeAttach},
-		Target:  []string{BlockStorageStatusCodeCreate},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getBlockStorage(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-			return instance, ncloud.StringValue(instance.Status), nil
-		},
-		Timeout:    DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"CREAT\": %s", err)
-	}
-
-	return nil
+func waitForBlockStorageDetachment(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	return waitForBlockStorageStatus(ctx, config, id, []string{BlockStorageStatusCodeAttach}, []string{BlockStorageStatusCodeCreate}, timeout)
 }
 
-func attachBlockStorage(d *schema.ResourceData, config *ProviderConfig) error {
+func attachBlockStorage(ctx context.Context, config *ProviderConfig, id string, serverInstanceNo string, timeout time.Duration) error {
 	var err error
 	if config.SupportVPC {
-		err = attachVpcBlockStorage(d, config)
+		err = attachVpcBlockStorage(config, id, serverInstanceNo)
 	} else {
-		err = attachClassicBlockStorage(d, config)
+		err = attachClassicBlockStorage(config, id, serverInstanceNo)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageAttachment(config, d.Id()); err != nil {
+	if err = waitForBlockStorageAttachment(ctx, config, id, timeout); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig) error {
+func attachClassicBlockStorage(config *ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &server.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
 	logCommonRequest("attachClassicBlockStorage", reqParams)
@@ -570,10 +551,10 @@ func attachClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig) e
 	return nil
 }
 
-func attachVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig) error {
+func attachVpcBlockStorage(config *ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &vserver.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
 	logCommonRequest("attachVpcBlockStorage", reqParams)
@@ -588,31 +569,40 @@ func attachVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig) error
 	return nil
 }
 
-func waitForBlockStorageAttachment(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeCreate},
-		Target:  []string{BlockStorageStatusCodeAttach},
+func waitForBlockStorageAttachment(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	return waitForBlockStorageStatus(ctx, config, id, []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeCreate}, []string{BlockStorageStatusCodeAttach}, timeout)
+}
+
+func waitForBlockStorageCreation(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	return waitForBlockStorageStatus(ctx, config, id, []string{BlockStorageStatusCodeInit}, []string{BlockStorageStatusCodeCreate}, timeout)
+}
+
+// waitForBlockStorageStatus waits for the status of the block storage. A deleted block storage is reported as TERMINATED.
+func waitForBlockStorageStatus(ctx context.Context, config *ProviderConfig, id string, pending, target []string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "BlockStorageInstance",
+		ID:      id,
+		Field:   "state",
+		Pending: pending,
+		Target:  target,
 		Refresh: func() (interface{}, string, error) {
 			instance, err := getBlockStorage(config, id)
 			if err != nil {
 				return 0, "", err
 			}
+			if instance == nil { // Instance is terminated.
+				return instance, InstanceStatusTerminated, nil
+			}
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"ATTAC\": %s", err)
-	}
-
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func changeBlockStorageSize(d *schema.ResourceData, config *ProviderConfig) error {
+func changeBlockStorageSize(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	var err error
 	if config.SupportVPC {
 		err = changeVpcBlockStorageSize(d, config)
@@ -624,7 +614,7 @@ func changeBlockStorageSize(d *schema.ResourceData, config *ProviderConfig) erro
 		return err
 	}
 
-	if err = waitForBlockStorageOperationIsNull(config, d.Id()); err != nil {
+	if err = waitForBlockStorageOperationIsNull(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
@@ -666,28 +656,28 @@ func changeClassicBlockStorageSize(d *schema.ResourceData, config *ProviderConfi
 	return nil
 }
 
-func waitForBlockStorageOperationIsNull(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"CHNG"},
-		Target:  []string{"NULL"},
+func waitForBlockStorageOperationIsNull(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "BlockStorageInstance",
+		ID:      id,
+		Field:   "operation",
+		Pending: []string{InstanceOperationChange},
+		Target:  []string{InstanceOperationNull},
 		Refresh: func() (interface{}, string, error) {
 			instance, err := getBlockStorage(config, id)
 			if err != nil {
 				return 0, "", err
 			}
+			if instance == nil {
+				return nil, "", fmt.Errorf("not found block storage instance(%s)", id)
+			}
 			return instance, ncloud.StringValue(instance.Operation), nil
 		},
-		Timeout:    DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for BlockStorageInstance operation to be \"NULL\": %s", err)
-	}
-
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
 //BlockStorage Dto for block storage
@@ -0,0 +1,118 @@
+package ncloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	RegisterResource("ncloud_block_storage_attachment", resourceNcloudBlockStorageAttachment())
+}
+
+func resourceNcloudBlockStorageAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNcloudBlockStorageAttachmentCreate,
+		ReadContext:   resourceNcloudBlockStorageAttachmentRead,
+		DeleteContext: resourceNcloudBlockStorageAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(DefaultUpdateTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"block_storage_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_instance_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudBlockStorageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*ProviderConfig)
+	blockStorageNo := d.Get("block_storage_no").(string)
+	serverInstanceNo := d.Get("server_instance_no").(string)
+
+	storage, err := getBlockStorage(config, blockStorageNo)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if storage == nil {
+		return diagFromErr(fmt.Errorf("no matching block storage [%s] found", blockStorageNo))
+	}
+
+	attachedServerInstanceNo := ncloud.StringValue(storage.ServerInstanceNo)
+	if attachedServerInstanceNo != "" && attachedServerInstanceNo != serverInstanceNo {
+		return diagFromErr(fmt.Errorf("block storage [%s] is already attached to server instance [%s]", blockStorageNo, attachedServerInstanceNo))
+	}
+
+	if attachedServerInstanceNo == "" {
+		log.Printf("[INFO] Attach block storage %q to server instance %q", blockStorageNo, serverInstanceNo)
+		if err := attachBlockStorage(ctx, config, blockStorageNo, serverInstanceNo, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	d.SetId(blockStorageNo)
+
+	return resourceNcloudBlockStorageAttachmentRead(ctx, d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*ProviderConfig)
+
+	storage, err := getBlockStorage(config, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if storage == nil || ncloud.StringValue(storage.ServerInstanceNo) == "" {
+		log.Printf("[WARN] Block storage %s is not attached, removing attachment from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("block_storage_no", storage.BlockStorageInstanceNo)
+	d.Set("server_instance_no", storage.ServerInstanceNo)
+	d.Set("device_name", storage.DeviceName)
+
+	return nil
+}
+
+func resourceNcloudBlockStorageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*ProviderConfig)
+
+	storage, err := getBlockStorage(config, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if storage == nil || ncloud.StringValue(storage.ServerInstanceNo) != d.Get("server_instance_no").(string) {
+		return nil
+	}
+
+	log.Printf("[INFO] Detach block storage %q from server instance %q", d.Id(), d.Get("server_instance_no"))
+	if err := detachBlockStorage(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNcloudBlockStorageAttachment_vpc_basic(t *testing.T) {
+	var storageInstance BlockStorage
+	name := fmt.Sprintf("tf-storage-attach-%s", acctest.RandString(5))
+	resourceName := "ncloud_block_storage_attachment.attachment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageAttachmentVpcConfig(name, "ncloud_server.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider("ncloud_block_storage.storage", &storageInstance, testAccProvider),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "block_storage_no", "ncloud_block_storage.storage", "id"),
+					resource.TestMatchResourceAttr(resourceName, "device_name", regexp.MustCompile(`^/dev/`)),
+				),
+			},
+			{
+				Config: testAccBlockStorageAttachmentVpcConfig(name, "ncloud_server.bar.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider("ncloud_block_storage.storage", &storageInstance, testAccProvider),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.bar", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageAttachmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_block_storage_attachment" {
+			continue
+		}
+
+		blockStorage, err := getBlockStorage(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if blockStorage != nil && blockStorage.ServerInstanceNo != nil && *blockStorage.ServerInstanceNo == rs.Primary.Attributes["server_instance_no"] {
+			return fmt.Errorf("block storage %s is still attached to %s", rs.Primary.ID, *blockStorage.ServerInstanceNo)
+		}
+	}
+
+	return nil
+}
+
+func testAccBlockStorageAttachmentVpcConfig(name, serverInstanceNo string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "foo" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-foo"
+	server_image_product_code = "SW.VSVR.OS.LNX64.CNTOS.0703.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_server" "bar" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-bar"
+	server_image_product_code = "SW.VSVR.OS.LNX64.CNTOS.0703.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_block_storage" "storage" {
+	name = "%[1]s"
+	size = "10"
+	zone = "KR-2"
+}
+
+resource "ncloud_block_storage_attachment" "attachment" {
+	block_storage_no   = ncloud_block_storage.storage.id
+	server_instance_no = %[2]s
+}
+`, name, serverInstanceNo)
+}
+
+func TestResourceNcloudBlockStorageAttachment_vpc_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	storageResource := p.ResourcesMap["ncloud_block_storage"]
+	r := p.ResourcesMap["ncloud_block_storage_attachment"]
+
+	_, subnetNo := e.seedVpc("10.7.0.0/16", "10.7.0.0/24")
+	serverInstanceNo := e.seedServer(subnetNo)
+
+	storage := testEmulatorApply(t, storageResource, nil, map[string]interface{}{
+		"name": "tf-emulator-storage",
+		"size": 10,
+		"zone": "KR-1",
+	}, config)
+	testCheckEmulatorState(t, storage, map[string]string{
+		"server_instance_no": "",
+		"status":             BlockStorageStatusCodeCreate,
+		"size":               "10",
+	})
+
+	state := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"block_storage_no":   storage.ID,
+		"server_instance_no": serverInstanceNo,
+	}, config)
+	testCheckEmulatorState(t, state, map[string]string{
+		"block_storage_no":   storage.ID,
+		"server_instance_no": serverInstanceNo,
+		"device_name":        "/dev/xvdb",
+	})
+
+	imported := testEmulatorImport(t, r, storage.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"server_instance_no": serverInstanceNo,
+	})
+
+	testEmulatorDestroy(t, r, state, config)
+
+	detached := testEmulatorRefresh(t, storageResource, storage, config)
+	testCheckEmulatorState(t, detached, map[string]string{
+		"server_instance_no": "",
+		"status":             BlockStorageStatusCodeCreate,
+	})
+
+	testEmulatorDestroy(t, storageResource, detached, config)
+}
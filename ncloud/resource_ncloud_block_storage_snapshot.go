@@ -1,13 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"log"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudBlockStorageSnapshotCreate,
-		Read:   resourceNcloudBlockStorageSnapshotRead,
-		Update: resourceNcloudBlockStorageSnapshotUpdate,
-		Delete: resourceNcloudBlockStorageSnapshotDelete,
+		CreateContext: resourceNcloudBlockStorageSnapshotCreate,
+		ReadContext:   resourceNcloudBlockStorageSnapshotRead,
+		UpdateContext: resourceNcloudBlockStorageSnapshotUpdate,
+		DeleteContext: resourceNcloudBlockStorageSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -107,13 +108,13 @@ func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 	}
 }
 
-func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	config := meta.(*ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_block_storage_snapshot`")
+		return diagFromErr(NotSupportVpc("resource `ncloud_block_storage_snapshot`"))
 	}
 
 	reqParams := buildRequestBlockStorageSnapshotInstance(d)
@@ -122,7 +123,7 @@ func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta inter
 	resp, err := client.server.V2Api.CreateBlockStorageSnapshotInstance(reqParams)
 	if err != nil {
 		logErrorResponse("CreateBlockStorageSnapshotInstance", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 	logCommonResponse("CreateBlockStorageSnapshotInstance", GetCommonResponse(resp))
 
@@ -141,23 +142,23 @@ func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta inter
 			return instance, ncloud.StringValue(instance.BlockStorageSnapshotInstanceStatus.Code), nil
 		},
 		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"CREAT\": %s", err)
+		return diagFromErr(fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"CREAT\": %s", err))
 	}
 
-	return resourceNcloudBlockStorageSnapshotRead(d, meta)
+	return resourceNcloudBlockStorageSnapshotRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	snapshot, err := getBlockStorageSnapshotInstance(client, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if snapshot != nil {
@@ -185,15 +186,15 @@ func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceNcloudBlockStorageSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudBlockStorageSnapshotRead(d, meta)
+func resourceNcloudBlockStorageSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudBlockStorageSnapshotRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	blockStorageSnapshotInstanceNo := d.Get("instance_no").(string)
-	if err := deleteBlockStorageSnapshotInstance(client, blockStorageSnapshotInstanceNo); err != nil {
-		return err
+	if err := deleteBlockStorageSnapshotInstance(ctx, client, blockStorageSnapshotInstanceNo); err != nil {
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -220,15 +221,23 @@ func getBlockStorageSnapshotInstanceList(client *NcloudAPIClient, blockStorageSn
 		BlockStorageSnapshotInstanceNoList: []*string{ncloud.String(blockStorageSnapshotInstanceNo)},
 	}
 
-	logCommonRequest("GetBlockStorageSnapshotInstanceList", reqParams)
+	var blockStorageSnapshotInstanceList []*server.BlockStorageSnapshotInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetBlockStorageSnapshotInstanceList", reqParams)
+		resp, err := client.server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetBlockStorageSnapshotInstanceList", err, reqParams)
+			return 0, nil, err
+		}
+		logCommonResponse("GetBlockStorageSnapshotInstanceList", GetCommonResponse(resp))
 
-	resp, err := client.server.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
+		blockStorageSnapshotInstanceList = append(blockStorageSnapshotInstanceList, resp.BlockStorageSnapshotInstanceList...)
+		return len(resp.BlockStorageSnapshotInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetBlockStorageSnapshotInstanceList", err, reqParams)
 		return nil, err
 	}
-	logCommonResponse("GetBlockStorageSnapshotInstanceList", GetCommonResponse(resp))
-	return resp.BlockStorageSnapshotInstanceList, nil
+	return blockStorageSnapshotInstanceList, nil
 }
 
 func getBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSnapshotInstanceNo string) (*server.BlockStorageSnapshotInstance, error) {
@@ -244,7 +253,7 @@ func getBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSnapsh
 	return nil, nil
 }
 
-func deleteBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSnapshotInstanceNo string) error {
+func deleteBlockStorageSnapshotInstance(ctx context.Context, client *NcloudAPIClient, blockStorageSnapshotInstanceNo string) error {
 	reqParams := server.DeleteBlockStorageSnapshotInstancesRequest{
 		BlockStorageSnapshotInstanceNoList: []*string{ncloud.String(blockStorageSnapshotInstanceNo)},
 	}
@@ -277,11 +286,11 @@ func deleteBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSna
 			return instance, ncloud.StringValue(instance.BlockStorageSnapshotInstanceStatus.Code), nil
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"TERMT\": %s", err)
 	}
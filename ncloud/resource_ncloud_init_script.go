@@ -1,10 +1,12 @@
 package ncloud
 
 import (
+	"context"
 	"log"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -15,11 +17,11 @@ func init() {
 
 func resourceNcloudInitScript() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudInitScriptCreate,
-		Read:   resourceNcloudInitScriptRead,
-		Delete: resourceNcloudInitScriptDelete,
+		CreateContext: resourceNcloudInitScriptCreate,
+		ReadContext:   resourceNcloudInitScriptRead,
+		DeleteContext: resourceNcloudInitScriptDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -57,27 +59,27 @@ func resourceNcloudInitScript() *schema.Resource {
 	}
 }
 
-func resourceNcloudInitScriptCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudInitScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := createInitScript(d, config)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*instance.InitScriptNo)
 	log.Printf("[INFO] Init script ID: %s", d.Id())
 
-	return resourceNcloudInitScriptRead(d, meta)
+	return resourceNcloudInitScriptRead(ctx, d, meta)
 }
 
-func resourceNcloudInitScriptRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudInitScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getInitScript(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -95,11 +97,11 @@ func resourceNcloudInitScriptRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudInitScriptDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudInitScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if err := deleteInitScript(config, d.Id()); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
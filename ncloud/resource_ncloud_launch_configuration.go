@@ -1,11 +1,13 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,9 +17,9 @@ func init() {
 
 func resourceNcloudLaunchConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLaunchConfigurationCreate,
-		Read:   resourceNcloudLaunchConfigurationRead,
-		Delete: resourceNcloudLaunchConfigurationDelete,
+		CreateContext: resourceNcloudLaunchConfigurationCreate,
+		ReadContext:   resourceNcloudLaunchConfigurationRead,
+		DeleteContext: resourceNcloudLaunchConfigurationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -83,15 +85,15 @@ func resourceNcloudLaunchConfiguration() *schema.Resource {
 	}
 }
 
-func resourceNcloudLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLaunchConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	id, err := createLaunchConfiguration(d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	return resourceNcloudLaunchConfigurationRead(d, meta)
+	return resourceNcloudLaunchConfigurationRead(ctx, d, meta)
 }
 
 func createLaunchConfiguration(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -132,7 +134,7 @@ func createClassicLaunchConfiguration(d *schema.ResourceData, config *ProviderCo
 		MemberServerImageNo:     StringPtrOrNil(d.GetOk("member_server_image_no")),
 		LoginKeyName:            StringPtrOrNil(d.GetOk("login_key_name")),
 		UserData:                StringPtrOrNil(d.GetOk("user_data")),
-		RegionNo:                &config.RegionNo,
+		RegionNo:                providerRegionNo(config),
 	}
 
 	if param, ok := d.GetOk("access_control_group_no_list"); ok {
@@ -149,12 +151,12 @@ func createClassicLaunchConfiguration(d *schema.ResourceData, config *ProviderCo
 	return res.LaunchConfigurationList[0].LaunchConfigurationNo, nil
 }
 
-func resourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	launchConfig, err := getLaunchConfiguration(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if launchConfig == nil {
@@ -185,19 +187,28 @@ func getVpcLaunchConfiguration(config *ProviderConfig, id string) (*LaunchConfig
 		reqParams.LaunchConfigurationNoList = []*string{ncloud.String(id)}
 	}
 
-	logCommonRequest("getVpcLaunchConfiguration", reqParams)
-	resp, err := config.Client.vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+	var launchConfigurationList []*vautoscaling.LaunchConfiguration
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcLaunchConfiguration", reqParams)
+		resp, err := config.Client.vautoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcLaunchConfiguration", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcLaunchConfiguration", resp)
+
+		launchConfigurationList = append(launchConfigurationList, resp.LaunchConfigurationList...)
+		return len(resp.LaunchConfigurationList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcLaunchConfiguration", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcLaunchConfiguration", resp)
 
-	if len(resp.LaunchConfigurationList) < 1 {
+	if len(launchConfigurationList) < 1 {
 		return nil, nil
 	}
 
-	l := resp.LaunchConfigurationList[0]
+	l := launchConfigurationList[0]
 
 	return &LaunchConfiguration{
 		LaunchConfigurationName:     l.LaunchConfigurationName,
@@ -215,17 +226,26 @@ func getVpcLaunchConfiguration(config *ProviderConfig, id string) (*LaunchConfig
 func getClassicLaunchConfiguration(config *ProviderConfig, id string) (*LaunchConfiguration, error) {
 	no := ncloud.String(id)
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: providerRegionNo(config),
 	}
-	logCommonRequest("getClassicLaunchConfiguration", reqParams)
-	resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+	var launchConfigurationList []*autoscaling.LaunchConfiguration
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicLaunchConfiguration", reqParams)
+		resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicLaunchConfiguration", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicLaunchConfiguration", resp)
+
+		launchConfigurationList = append(launchConfigurationList, resp.LaunchConfigurationList...)
+		return len(resp.LaunchConfigurationList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicLaunchConfiguration", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicLaunchConfiguration", resp)
 
-	for _, l := range resp.LaunchConfigurationList {
+	for _, l := range launchConfigurationList {
 		if *l.LaunchConfigurationNo == *no {
 			return &LaunchConfiguration{
 				LaunchConfigurationNo:       l.LaunchConfigurationNo,
@@ -243,12 +263,12 @@ func getClassicLaunchConfiguration(config *ProviderConfig, id string) (*LaunchCo
 	return nil, nil
 }
 
-func resourceNcloudLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLaunchConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	err := deleteLaunchConfiguration(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -304,14 +324,23 @@ func deleteClassicLaunchConfiguration(config *ProviderConfig, id string) error {
 
 func getClassicLaunchConfigurationByNo(no *string, config *ProviderConfig) (*LaunchConfiguration, error) {
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: providerRegionNo(config),
 	}
-	resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+	var launchConfigurationList []*autoscaling.LaunchConfiguration
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		launchConfigurationList = append(launchConfigurationList, resp.LaunchConfigurationList...)
+		return len(resp.LaunchConfigurationList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, l := range resp.LaunchConfigurationList {
+	for _, l := range launchConfigurationList {
 		if *l.LaunchConfigurationNo == *no {
 			return &LaunchConfiguration{
 				LaunchConfigurationNo:       l.LaunchConfigurationNo,
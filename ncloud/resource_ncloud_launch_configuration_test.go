@@ -157,3 +157,32 @@ resource "ncloud_launch_configuration" "lc" {
 }
 `, serverImageProductCode)
 }
+
+func TestResourceNcloudLaunchConfiguration_vpc_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_launch_configuration"]
+
+	state := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"name":                      "tf-emulator-lc",
+		"server_image_product_code": "SW.VSVR.OS.LNX64.CNTOS.0703.B050",
+	}, config)
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":                      "tf-emulator-lc",
+		"launch_configuration_no":   state.ID,
+		"server_image_product_code": "SW.VSVR.OS.LNX64.CNTOS.0703.B050",
+	})
+
+	imported := testEmulatorImport(t, r, state.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"name": "tf-emulator-lc",
+	})
+
+	testEmulatorDestroy(t, r, state, config)
+
+	if instance, err := getVpcLaunchConfiguration(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected launch configuration to be deleted, got %v, %v", instance, err)
+	}
+}
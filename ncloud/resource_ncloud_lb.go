@@ -7,7 +7,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"time"
@@ -112,7 +111,7 @@ func resourceNcloudLb() *schema.Resource {
 func resourceNcloudLbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb`"))
 	}
 	reqParams := &vloadbalancer.CreateLoadBalancerInstanceRequest{
 		RegionCode: &config.RegionCode,
@@ -134,17 +133,17 @@ func resourceNcloudLbCreate(ctx context.Context, d *schema.ResourceData, meta in
 	for _, subnetNo := range reqParams.SubnetNoList {
 		subnet, err := getSubnetInstance(config, *subnetNo)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if subnet == nil {
-			return diag.FromErr(fmt.Errorf("not found subnet(%s)", *subnetNo))
+			return diagFromErr(fmt.Errorf("not found subnet(%s)", *subnetNo))
 		}
 		subnetList = append(subnetList, subnet)
 		vpcNoMap[*subnet.VpcNo]++
 	}
 
 	if len(vpcNoMap) > 1 {
-		return diag.FromErr(fmt.Errorf("subnet must be set to the subnet of the same vpc"))
+		return diagFromErr(fmt.Errorf("subnet must be set to the subnet of the same vpc"))
 	}
 
 	reqParams.VpcNo = subnetList[0].VpcNo
@@ -153,11 +152,11 @@ func resourceNcloudLbCreate(ctx context.Context, d *schema.ResourceData, meta in
 	resp, err := config.Client.vloadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
 		logErrorResponse("resourceNcloudLbCreate", err, reqParams)
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	logResponse("resourceNcloudLbCreate", resp)
-	if err := waitForLoadBalancerActive(ctx, d, config, ncloud.StringValue(resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo)); err != nil {
-		return diag.FromErr(err)
+	if err := waitForLoadBalancerActive(ctx, config, ncloud.StringValue(resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diagFromErr(err)
 	}
 	d.SetId(ncloud.StringValue(resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo))
 	return resourceNcloudLbRead(ctx, d, meta)
@@ -166,13 +165,13 @@ func resourceNcloudLbCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb`"))
 	}
 
 	lb, err := getVpcLoadBalancer(config, d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if lb == nil {
@@ -188,11 +187,11 @@ func resourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb`"))
 	}
 	if d.HasChanges("idle_timeout", "throughput_type") {
-		if err := waitForLoadBalancerActive(ctx, d, config, d.Id()); err != nil {
-			return diag.FromErr(err)
+		if err := waitForLoadBalancerActive(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diagFromErr(err)
 		}
 		_, err := config.Client.vloadbalancer.V2Api.ChangeLoadBalancerInstanceConfiguration(&vloadbalancer.ChangeLoadBalancerInstanceConfigurationRequest{
 			RegionCode:             &config.RegionCode,
@@ -201,13 +200,13 @@ func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			ThroughputTypeCode:     StringPtrOrNil(d.GetOk("throughput_type")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChanges("description") {
-		if err := waitForLoadBalancerActive(ctx, d, config, d.Id()); err != nil {
-			return diag.FromErr(err)
+		if err := waitForLoadBalancerActive(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diagFromErr(err)
 		}
 		_, err := config.Client.vloadbalancer.V2Api.SetLoadBalancerDescription(&vloadbalancer.SetLoadBalancerDescriptionRequest{
 			RegionCode:              &config.RegionCode,
@@ -215,7 +214,7 @@ func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			LoadBalancerDescription: StringPtrOrNil(d.GetOk("description")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	return resourceNcloudLbRead(ctx, d, config)
@@ -224,32 +223,34 @@ func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceNcloudLbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb`"))
 	}
 	deleteInstanceReqParams := &vloadbalancer.DeleteLoadBalancerInstancesRequest{
 		RegionCode:                 &config.RegionCode,
 		LoadBalancerInstanceNoList: ncloud.StringList([]string{d.Id()}),
 	}
 
-	if err := waitForLoadBalancerActive(ctx, d, config, d.Id()); err != nil {
-		return diag.FromErr(err)
+	if err := waitForLoadBalancerActive(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
 	}
 
 	logCommonRequest("resourceNcloudLbDelete", deleteInstanceReqParams)
 	if _, err := config.Client.vloadbalancer.V2Api.DeleteLoadBalancerInstances(deleteInstanceReqParams); err != nil {
 		logErrorResponse("resourceNcloudLbDelete", err, deleteInstanceReqParams)
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := waitForLoadBalancerDeletion(ctx, d, config); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
 func waitForLoadBalancerDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &statusWaiter{
+		Name:    "LoadBalancerInstance",
+		ID:      d.Id(),
 		Pending: []string{LoadBalancerInstanceOperationTerminateCode},
 		Target:  []string{LoadBalancerInstanceOperationNullCode},
 		Refresh: func() (result interface{}, state string, err error) {
@@ -269,18 +270,17 @@ func waitForLoadBalancerDeletion(ctx context.Context, d *schema.ResourceData, co
 			lb := resp.LoadBalancerInstanceList[0]
 			return resp, ncloud.StringValue(lb.LoadBalancerInstanceOperation.Code), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("Error waiting for Load Balancer instance (%s) to become terminating: %s", d.Id(), err)
-	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForLoadBalancerActive(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForLoadBalancerActive(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "LoadBalancerInstance",
+		ID:      id,
 		Pending: []string{LoadBalancerInstanceOperationCreateCode, LoadBalancerInstanceOperationChangeCode},
 		Target:  []string{LoadBalancerInstanceOperationNullCode},
 		Refresh: func() (result interface{}, state string, err error) {
@@ -300,14 +300,11 @@ func waitForLoadBalancerActive(ctx context.Context, d *schema.ResourceData, conf
 			lb := resp.LoadBalancerInstanceList[0]
 			return resp, ncloud.StringValue(lb.LoadBalancerInstanceOperation.Code), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: timeout,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("error waiting for Load Balancer instance (%s) to become activating: %s", id, err)
-	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
 func getVpcLoadBalancer(config *ProviderConfig, id string) (*LoadBalancerInstance, error) {
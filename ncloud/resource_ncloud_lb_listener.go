@@ -84,7 +84,7 @@ func resourceNcloudLbListener() *schema.Resource {
 func resourceNcloudLbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
 	}
 
 	reqParams := &vloadbalancer.CreateLoadBalancerListenerRequest{
@@ -116,7 +116,7 @@ func resourceNcloudLbListenerCreate(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(listener.LoadBalancerListenerNo))
@@ -126,12 +126,12 @@ func resourceNcloudLbListenerCreate(ctx context.Context, d *schema.ResourceData,
 func resourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
 	}
 
 	listener, err := getVpcLoadBalancerListener(config, d.Id(), d.Get("load_balancer_no").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if listener == nil {
@@ -147,7 +147,7 @@ func resourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData, m
 func resourceNcloudLbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
 	}
 
 	if d.HasChanges("port", "protocol", "ssl_certificate_no", "use_http2", "tls_min_version_type") {
@@ -177,7 +177,7 @@ func resourceNcloudLbListenerUpdate(ctx context.Context, d *schema.ResourceData,
 		})
 
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -187,7 +187,7 @@ func resourceNcloudLbListenerUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceNcloudLbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_listener`"))
 	}
 	reqParams := &vloadbalancer.DeleteLoadBalancerListenersRequest{
 		RegionCode:                 &config.RegionCode,
@@ -207,7 +207,7 @@ func resourceNcloudLbListenerDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return nil
 }
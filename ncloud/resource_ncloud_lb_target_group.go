@@ -146,7 +146,7 @@ func resourceNcloudLbTargetGroup() *schema.Resource {
 func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 	reqParams := &vloadbalancer.CreateTargetGroupRequest{
 		RegionCode: &config.RegionCode,
@@ -161,11 +161,11 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if err := validateVpcTargetGroupVpc(config, *reqParams.VpcNo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := validateVpcTargetGroupDuplicateName(config, ncloud.StringValue(reqParams.TargetGroupName)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if healthChecks, ok := d.GetOk("health_check"); ok {
@@ -179,13 +179,13 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 		// Required
 		reqParams.HealthCheckProtocolTypeCode = ncloud.String(healthCheck["protocol"].(string))
 		if err := validateHealthCheckProtocolByTargetGroupProtocol(*reqParams.TargetGroupProtocolTypeCode, *reqParams.HealthCheckProtocolTypeCode); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		if *reqParams.HealthCheckProtocolTypeCode == "HTTP" || *reqParams.HealthCheckProtocolTypeCode == "HTTPS" {
 			reqParams.HealthCheckUrlPath = ncloud.String(healthCheck["url_path"].(string))
 			if healthCheck["http_method"] == "" {
-				return diag.FromErr(fmt.Errorf("http_method is required if the health check protocol type is HTTP or HTTPS."))
+				return diagFromErr(fmt.Errorf("http_method is required if the health check protocol type is HTTP or HTTPS."))
 			}
 			reqParams.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck["http_method"].(string))
 		}
@@ -196,7 +196,7 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 	logResponse("resourceNcloudTargetGroupCreate", resp)
 	if err != nil {
 		logErrorResponse("resourceNcloudTargetGroupCreate", err, reqParams)
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(resp.TargetGroupList[0].TargetGroupNo))
@@ -206,12 +206,12 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 func resourceNcloudTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 
 	tg, err := getVpcLoadBalancerTargetGroup(config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if tg == nil {
@@ -227,7 +227,7 @@ func resourceNcloudTargetGroupRead(ctx context.Context, d *schema.ResourceData,
 func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 	if d.HasChange("health_check") {
 		reqParams := &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest{
@@ -247,7 +247,7 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 			if healthCheckProtocol == "HTTP" || healthCheckProtocol == "HTTPS" {
 				reqParams.HealthCheckUrlPath = ncloud.String(healthCheck["url_path"].(string))
 				if healthCheck["http_method"] == "" {
-					return diag.FromErr(fmt.Errorf("http_method is required if the health check protocol type is HTTP or HTTPS."))
+					return diagFromErr(fmt.Errorf("http_method is required if the health check protocol type is HTTP or HTTPS."))
 				}
 				reqParams.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck["http_method"].(string))
 			}
@@ -255,7 +255,7 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 		logCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.vloadbalancer.V2Api.ChangeTargetGroupHealthCheckConfiguration(reqParams); err != nil {
 			logErrorResponse("resourceNcloudTargetGroupUpdate", err, reqParams)
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -275,11 +275,11 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if err := validateAlgorithmTypeByTargetGroupProtocol(*reqParams.AlgorithmTypeCode, targetGroupProtocol); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		logCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.vloadbalancer.V2Api.ChangeTargetGroupConfiguration(reqParams); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -289,14 +289,14 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 func resourceNcloudTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 	reqParams := &vloadbalancer.DeleteTargetGroupsRequest{
 		RegionCode:        &config.RegionCode,
 		TargetGroupNoList: []*string{ncloud.String(d.Id())},
 	}
 	if _, err := config.Client.vloadbalancer.V2Api.DeleteTargetGroups(reqParams); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -308,17 +308,26 @@ func getVpcLoadBalancerTargetGroup(config *ProviderConfig, id string) (*TargetGr
 		TargetGroupNoList: []*string{ncloud.String(id)},
 	}
 
-	logCommonRequest("getLbTargetGroup", reqParams)
-	resp, err := config.Client.vloadbalancer.V2Api.GetTargetGroupList(reqParams)
+	var targetGroupList []*vloadbalancer.TargetGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getLbTargetGroup", reqParams)
+		resp, err := config.Client.vloadbalancer.V2Api.GetTargetGroupList(reqParams)
+		if err != nil {
+			logErrorResponse("getLbTargetGroup", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getLbTargetGroup", resp)
+
+		targetGroupList = append(targetGroupList, resp.TargetGroupList...)
+		return len(resp.TargetGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getLbTargetGroup", err, reqParams)
 		return nil, err
 	}
-	logResponse("getLbTargetGroup", resp)
-	if len(resp.TargetGroupList) < 1 {
+	if len(targetGroupList) < 1 {
 		return nil, nil
 	}
-	tg := convertVpcTargetGroup(resp.TargetGroupList[0])
+	tg := convertVpcTargetGroup(targetGroupList[0])
 	return tg, nil
 }
 
@@ -51,7 +51,7 @@ func resourceNcloudLbTargetGroupAttachment() *schema.Resource {
 func resourceNcloudLbTargetGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group_attachment`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group_attachment`"))
 	}
 	reqParams := &vloadbalancer.AddTargetRequest{
 		RegionCode:    &config.RegionCode,
@@ -62,7 +62,7 @@ func resourceNcloudLbTargetGroupAttachmentCreate(ctx context.Context, d *schema.
 	err := waitForAddTarget(ctx, d, config, reqParams)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(time.Now().UTC().String())
@@ -72,7 +72,7 @@ func resourceNcloudLbTargetGroupAttachmentCreate(ctx context.Context, d *schema.
 func resourceNcloudLbTargetGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 
 	targetNoList, err := getVpcLoadBalancerTargetGroupAttachment(config, d.Get("target_group_no").(string), ncloud.StringListValue(ncloud.StringInterfaceList(d.Get("target_no_list").([]interface{}))))
@@ -83,7 +83,7 @@ func resourceNcloudLbTargetGroupAttachmentRead(ctx context.Context, d *schema.Re
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if targetNoList == nil {
@@ -98,7 +98,7 @@ func resourceNcloudLbTargetGroupAttachmentRead(ctx context.Context, d *schema.Re
 func resourceNcloudLbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group`"))
 	}
 	if d.HasChange("target_no_list") {
 		o, n := d.GetChange("target_no_list")
@@ -141,7 +141,7 @@ func resourceNcloudLbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.
 			addErr := waitForAddTarget(ctx, d, config, addReqParams)
 
 			if addErr != nil {
-				return diag.FromErr(addErr)
+				return diagFromErr(addErr)
 			}
 		}
 
@@ -155,7 +155,7 @@ func resourceNcloudLbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.
 			removeErr := waitForRemoveTarget(ctx, d, config, removeReqParams)
 
 			if removeErr != nil {
-				return diag.FromErr(removeErr)
+				return diagFromErr(removeErr)
 			}
 		}
 	}
@@ -165,7 +165,7 @@ func resourceNcloudLbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.
 func resourceNcloudLbTargetGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_lb_target_group_attachment`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_lb_target_group_attachment`"))
 	}
 	reqParams := &vloadbalancer.RemoveTargetRequest{
 		RegionCode:    &config.RegionCode,
@@ -176,7 +176,7 @@ func resourceNcloudLbTargetGroupAttachmentDelete(ctx context.Context, d *schema.
 	err := waitForRemoveTarget(ctx, d, config, reqParams)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return nil
 }
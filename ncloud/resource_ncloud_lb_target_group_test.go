@@ -206,3 +206,57 @@ resource "ncloud_lb_target_group" "test" {
 }
 `)
 }
+
+func TestResourceNcloudLbTargetGroup_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_lb_target_group"]
+
+	vpcNo, _ := e.seedVpc("10.0.0.0/16", "10.0.0.0/24")
+
+	raw := map[string]interface{}{
+		"vpc_no":      vpcNo,
+		"protocol":    "HTTP",
+		"target_type": "VSVR",
+		"port":        8080,
+		"name":        "tf-emulator-tg",
+		"description": "for test",
+		"health_check": []interface{}{map[string]interface{}{
+			"protocol":       "HTTP",
+			"http_method":    "GET",
+			"port":           8080,
+			"url_path":       "/monitor/l7check",
+			"cycle":          30,
+			"up_threshold":   2,
+			"down_threshold": 2,
+		}},
+		"algorithm_type": "RR",
+	}
+	state := testEmulatorApply(t, r, nil, raw, config)
+	testCheckEmulatorState(t, state, map[string]string{
+		"vpc_no":                     vpcNo,
+		"name":                       "tf-emulator-tg",
+		"port":                       "8080",
+		"health_check.#":             "1",
+		"health_check.0.url_path":    "/monitor/l7check",
+		"health_check.0.http_method": "GET",
+		"algorithm_type":             "RR",
+	})
+
+	raw["health_check"].([]interface{})[0].(map[string]interface{})["url_path"] = "/health"
+	raw["algorithm_type"] = "LC"
+	updated := testEmulatorApply(t, r, state, raw, config)
+	testCheckEmulatorState(t, updated, map[string]string{
+		"target_group_no":         state.ID,
+		"health_check.0.url_path": "/health",
+		"algorithm_type":          "LC",
+	})
+
+	testEmulatorDestroy(t, r, updated, config)
+
+	if instance, err := getVpcLoadBalancerTargetGroup(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected target group to be deleted, got %v, %v", instance, err)
+	}
+}
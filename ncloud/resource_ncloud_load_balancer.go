@@ -1,13 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLoadBalancerCreate,
-		Read:   resourceNcloudLoadBalancerRead,
-		Update: resourceNcloudLoadBalancerUpdate,
-		Delete: resourceNcloudLoadBalancerDelete,
+		CreateContext: resourceNcloudLoadBalancerCreate,
+		ReadContext:   resourceNcloudLoadBalancerRead,
+		UpdateContext: resourceNcloudLoadBalancerUpdate,
+		DeleteContext: resourceNcloudLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -128,63 +129,42 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 	}
 }
 
-func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	config := meta.(*ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_load_balancer`")
+		return diagFromErr(NotSupportVpc("resource `ncloud_load_balancer`"))
 	}
 
-	reqParams, err := buildCreateLoadBalancerInstanceParams(d)
+	reqParams, err := buildCreateLoadBalancerInstanceParams(d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	logCommonRequest("CreateLoadBalancerInstance", reqParams)
 	resp, err := client.loadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
 		logErrorResponse("CreateLoadBalancerInstance", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 	logCommonResponse("CreateLoadBalancerInstance", GetCommonResponse(resp))
 
 	loadBalancerInstance := resp.LoadBalancerInstanceList[0]
 	d.SetId(*loadBalancerInstance.LoadBalancerInstanceNo)
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"INIT", "USE"},
-		Target:  []string{"USED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getLoadBalancerInstance(client, ncloud.StringValue(loadBalancerInstance.LoadBalancerInstanceNo))
-			if err != nil {
-				return 0, "", err
-			}
-
-			if ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code) == "NULL" {
-				return instance, ncloud.StringValue(instance.LoadBalancerInstanceStatus.Code), nil
-			}
-
-			return instance, ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code), nil
-		},
-		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+	if err := waitForLoadBalancerInstanceUsed(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudLoadBalancerRead(d, meta)
+	return resourceNcloudLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	lb, err := getLoadBalancerInstance(client, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if lb != nil {
@@ -215,13 +195,13 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 
 		if len(lb.LoadBalancerRuleList) != 0 {
 			if err := d.Set("rule_list", flattenLoadBalancerRuleList(lb.LoadBalancerRuleList)); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 
 		if len(lb.LoadBalancedServerInstanceList) != 0 {
 			if err := d.Set("load_balanced_server_instance_list", flattenLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList)); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		} else {
 			d.Set("load_balanced_server_instance_list", nil)
@@ -234,22 +214,22 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceNcloudLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
-	if err := deleteLoadBalancerInstance(client, d.Id()); err != nil {
-		return err
+	if err := deleteLoadBalancerInstance(ctx, client, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	// Change Load Balanced Server Instances
 	if d.HasChange("server_instance_no_list") {
-		if err := changeLoadBalancedServerInstances(client, d); err != nil {
-			return err
+		if err := changeLoadBalancedServerInstances(ctx, client, d); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -271,40 +251,19 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 		resp, err := client.loadbalancer.V2Api.ChangeLoadBalancerInstanceConfiguration(reqParams)
 		if err != nil {
 			logErrorResponse("ChangeLoadBalancerInstanceConfiguration", err, reqParams)
-			return err
+			return diagFromErr(err)
 		}
 		logCommonResponse("ChangeLoadBalancerInstanceConfiguration", GetCommonResponse(resp))
 
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"INIT", "USE"},
-			Target:  []string{"USED"},
-			Refresh: func() (interface{}, string, error) {
-				instance, err := getLoadBalancerInstance(client, d.Id())
-				if err != nil {
-					return 0, "", err
-				}
-
-				if ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code) == "NULL" {
-					return instance, ncloud.StringValue(instance.LoadBalancerInstanceStatus.Code), nil
-				}
-
-				return instance, ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code), nil
-			},
-			Timeout:    DefaultUpdateTimeout,
-			Delay:      2 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+		if err := waitForLoadBalancerInstanceUsed(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudLoadBalancerRead(d, meta)
+	return resourceNcloudLoadBalancerRead(ctx, d, meta)
 }
 
-func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.ResourceData) error {
+func changeLoadBalancedServerInstances(ctx context.Context, client *NcloudAPIClient, d *schema.ResourceData) error {
 	reqParams := &loadbalancer.ChangeLoadBalancedServerInstancesRequest{
 		LoadBalancerInstanceNo: ncloud.String(d.Id()),
 		ServerInstanceNoList:   expandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
@@ -319,36 +278,41 @@ func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.Resour
 	}
 	logCommonResponse("ChangeLoadBalancedServerInstances", GetCommonResponse(resp))
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"INIT", "USE"},
+	return waitForLoadBalancerInstanceUsed(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+// waitForLoadBalancerInstanceUsed waits until the load balancer has no operation in progress and is in use.
+func waitForLoadBalancerInstanceUsed(ctx context.Context, client *NcloudAPIClient, id string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "LoadBalancerInstance",
+		ID:      id,
+		Pending: []string{InstanceStatusInit, "USE"},
 		Target:  []string{"USED"},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := getLoadBalancerInstance(client, d.Id())
+			instance, err := getLoadBalancerInstance(client, id)
 			if err != nil {
 				return 0, "", err
 			}
 
-			if ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code) == "NULL" {
+			if instance == nil {
+				return nil, "", fmt.Errorf("not found load balancer instance(%s)", id)
+			}
+
+			if ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code) == InstanceOperationNull {
 				return instance, ncloud.StringValue(instance.LoadBalancerInstanceStatus.Code), nil
 			}
 
 			return instance, ncloud.StringValue(instance.LoadBalancerInstanceOperation.Code), nil
 		},
-		Timeout:    DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+		Timeout: timeout,
 	}
 
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
-	regionNo, err := parseRegionNoParameter(d)
+func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData, config *ProviderConfig) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -388,15 +352,24 @@ func getLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo str
 	reqParams := &loadbalancer.GetLoadBalancerInstanceListRequest{
 		LoadBalancerInstanceNoList: []*string{ncloud.String(loadBalancerInstanceNo)},
 	}
-	logCommonRequest("GetLoadBalancerInstanceList", reqParams)
-	resp, err := client.loadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+	var loadBalancerInstanceList []*loadbalancer.LoadBalancerInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetLoadBalancerInstanceList", reqParams)
+		resp, err := client.loadbalancer.V2Api.GetLoadBalancerInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("GetLoadBalancerInstanceList", err, reqParams)
+			return 0, nil, err
+		}
+		logCommonResponse("GetLoadBalancerInstanceList", GetCommonResponse(resp))
+
+		loadBalancerInstanceList = append(loadBalancerInstanceList, resp.LoadBalancerInstanceList...)
+		return len(resp.LoadBalancerInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetLoadBalancerInstanceList", err, reqParams)
 		return nil, err
 	}
-	logCommonResponse("GetLoadBalancerInstanceList", GetCommonResponse(resp))
 
-	for _, inst := range resp.LoadBalancerInstanceList {
+	for _, inst := range loadBalancerInstanceList {
 		if loadBalancerInstanceNo == ncloud.StringValue(inst.LoadBalancerInstanceNo) {
 			return inst, nil
 		}
@@ -404,7 +377,7 @@ func getLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo str
 	return nil, nil
 }
 
-func deleteLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo string) error {
+func deleteLoadBalancerInstance(ctx context.Context, client *NcloudAPIClient, loadBalancerInstanceNo string, timeout time.Duration) error {
 	reqParams := &loadbalancer.DeleteLoadBalancerInstancesRequest{
 		LoadBalancerInstanceNoList: []*string{ncloud.String(loadBalancerInstanceNo)},
 	}
@@ -420,7 +393,10 @@ func deleteLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo
 	}
 	logCommonResponse("DeleteLoadBalancerInstance", commonResponse)
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &statusWaiter{
+		Name:    "LoadBalancerInstance",
+		ID:      loadBalancerInstanceNo,
+		Field:   "deletion",
 		Pending: []string{"", "USED"},
 		Target:  []string{"OK"},
 		Refresh: func() (interface{}, string, error) {
@@ -430,22 +406,16 @@ func deleteLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo
 			}
 
 			if instance == nil {
-				return 0, "OK", err
+				return 0, "OK", nil
 			}
 
 			return instance, "", nil
 		},
-		Timeout:    DefaultUpdateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
 	}
 
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting to delete LoadBalancerInstance: %s", err)
-	}
-
-	return nil
+	_, err = waiter.Wait(ctx)
+	return err
 }
 
 var loadBalancerRuleSchemaResource = &schema.Resource{
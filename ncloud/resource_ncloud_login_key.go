@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,11 +21,11 @@ func init() {
 
 func resourceNcloudLoginKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLoginKeyCreate,
-		Read:   resourceNcloudLoginKeyRead,
-		Delete: resourceNcloudLoginKeyDelete,
+		CreateContext: resourceNcloudLoginKeyCreate,
+		ReadContext:   resourceNcloudLoginKeyRead,
+		DeleteContext: resourceNcloudLoginKeyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultTimeout),
@@ -50,12 +52,12 @@ func resourceNcloudLoginKey() *schema.Resource {
 	}
 }
 
-func resourceNcloudLoginKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoginKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keyName := d.Get("key_name").(string)
 
 	fingerprint, err := getFingerPrint(meta.(*ProviderConfig), &keyName)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if fingerprint != nil {
@@ -68,7 +70,7 @@ func resourceNcloudLoginKeyRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceNcloudLoginKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoginKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var privateKey *string
 	var err error
 
@@ -81,7 +83,7 @@ func resourceNcloudLoginKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(keyName)
@@ -89,21 +91,21 @@ func resourceNcloudLoginKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	time.Sleep(time.Second * 1) // for internal Master / Slave DB sync
 
-	return resourceNcloudLoginKeyRead(d, meta)
+	return resourceNcloudLoginKeyRead(ctx, d, meta)
 }
 
-func resourceNcloudLoginKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoginKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	keyName := d.Get("key_name").(string)
 
 	if config.SupportVPC == true {
-		if err := deleteVpcLoginKey(config.Client, &keyName); err != nil {
-			return err
+		if err := deleteVpcLoginKey(ctx, config.Client, &keyName); err != nil {
+			return diagFromErr(err)
 		}
 	} else {
-		if err := deleteClassicLoginKey(config.Client, &keyName); err != nil {
-			return err
+		if err := deleteClassicLoginKey(ctx, config.Client, &keyName); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -118,16 +120,25 @@ func getClassicFingerPrintList(client *NcloudAPIClient, keyName *string) ([]*str
 		reqParams.KeyName = keyName
 	}
 
-	logCommonRequest("getClassicFingerPrintList", reqParams)
-	resp, err := client.server.V2Api.GetLoginKeyList(reqParams)
+	var loginKeyList []*server.LoginKey
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicFingerPrintList", reqParams)
+		resp, err := client.server.V2Api.GetLoginKeyList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicFingerPrintList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicFingerPrintList", resp)
+
+		loginKeyList = append(loginKeyList, resp.LoginKeyList...)
+		return len(resp.LoginKeyList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicFingerPrintList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicFingerPrintList", resp)
 
-	keyList := make([]*string, 0, *resp.TotalRows)
-	for _, v := range resp.LoginKeyList {
+	keyList := make([]*string, 0, len(loginKeyList))
+	for _, v := range loginKeyList {
 		keyList = append(keyList, v.Fingerprint)
 	}
 
@@ -140,16 +151,25 @@ func getVpcFingerPrintList(client *NcloudAPIClient, keyName *string) ([]*string,
 		reqParams.KeyName = keyName
 	}
 
-	logCommonRequest("getVpcFingerPrintList", reqParams)
-	resp, err := client.vserver.V2Api.GetLoginKeyList(reqParams)
+	var loginKeyList []*vserver.LoginKey
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcFingerPrintList", reqParams)
+		resp, err := client.vserver.V2Api.GetLoginKeyList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcFingerPrintList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcFingerPrintList", resp)
+
+		loginKeyList = append(loginKeyList, resp.LoginKeyList...)
+		return len(resp.LoginKeyList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcFingerPrintList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcFingerPrintList", resp)
 
-	keyList := make([]*string, 0, *resp.TotalRows)
-	for _, v := range resp.LoginKeyList {
+	keyList := make([]*string, 0, len(loginKeyList))
+	for _, v := range loginKeyList {
 		keyList = append(keyList, v.Fingerprint)
 	}
 
@@ -177,7 +197,7 @@ func getFingerPrint(config *ProviderConfig, keyName *string) (*string, error) {
 	return nil, nil
 }
 
-func deleteClassicLoginKey(client *NcloudAPIClient, keyName *string) error {
+func deleteClassicLoginKey(ctx context.Context, client *NcloudAPIClient, keyName *string) error {
 	reqParams := &server.DeleteLoginKeyRequest{KeyName: keyName}
 
 	logCommonRequest("deleteClassicLoginKey", reqParams)
@@ -204,11 +224,11 @@ func deleteClassicLoginKey(client *NcloudAPIClient, keyName *string) error {
 			return resp, "", nil
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete LoginKey: %s", err)
 	}
@@ -216,7 +236,7 @@ func deleteClassicLoginKey(client *NcloudAPIClient, keyName *string) error {
 	return nil
 }
 
-func deleteVpcLoginKey(client *NcloudAPIClient, keyName *string) error {
+func deleteVpcLoginKey(ctx context.Context, client *NcloudAPIClient, keyName *string) error {
 	reqParams := &vserver.DeleteLoginKeysRequest{KeyNameList: []*string{keyName}}
 
 	logCommonRequest("deleteVpcLoginKey", reqParams)
@@ -243,11 +263,11 @@ func deleteVpcLoginKey(client *NcloudAPIClient, keyName *string) error {
 			return resp, "", nil
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete LoginKey: %s", err)
 	}
@@ -1,14 +1,13 @@
 package ncloud
 
 import (
-	"fmt"
+	"context"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
 	"log"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,12 +18,12 @@ func init() {
 
 func resourceNcloudNasVolume() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNasVolumeCreate,
-		Read:   resourceNcloudNasVolumeRead,
-		Update: resourceNcloudNasVolumeUpdate,
-		Delete: resourceNcloudNasVolumeDelete,
+		CreateContext: resourceNcloudNasVolumeCreate,
+		ReadContext:   resourceNcloudNasVolumeRead,
+		UpdateContext: resourceNcloudNasVolumeUpdate,
+		DeleteContext: resourceNcloudNasVolumeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -66,9 +65,10 @@ func resourceNcloudNasVolume() *schema.Resource {
 				ForceNew: true,
 			},
 			"cifs_user_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"description": {
 				Type:             schema.TypeString,
@@ -120,26 +120,26 @@ func resourceNcloudNasVolume() *schema.Resource {
 	}
 }
 
-func resourceNcloudNasVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	id, err := createNasVolume(d, config)
+	id, err := createNasVolume(ctx, d, config)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] NAS Volume ID: %s", d.Id())
 
-	return resourceNcloudNasVolumeRead(d, meta)
+	return resourceNcloudNasVolumeRead(ctx, d, meta)
 }
 
-func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	r, err := getNasVolume(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if r == nil {
@@ -154,33 +154,33 @@ func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceNcloudNasVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	if err := deleteNasVolume(d, config, d.Id()); err != nil {
-		return err
+	if err := deleteNasVolume(ctx, d, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNasVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("volume_size") {
 		if err := changeNasVolumeSize(d, config); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("server_instance_no_list") || d.HasChange("custom_ip_list") {
 		if err := setNasVolumeAccessControl(d, config); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudNasVolumeRead(d, meta)
+	return resourceNcloudNasVolumeRead(ctx, d, meta)
 }
 
 func getNasVolume(config *ProviderConfig, id string) (*NasVolume, error) {
@@ -280,7 +280,7 @@ func convertVpcNasVolume(inst *vnas.NasVolumeInstance) *NasVolume {
 	}
 }
 
-func createNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createNasVolume(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	var id *string
 	var err error
 
@@ -294,7 +294,7 @@ func createNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, e
 		return nil, err
 	}
 
-	if err := waitForNasVolumeCreation(d, config, *id); err != nil {
+	if err := waitForNasVolumeCreation(ctx, d, config, *id); err != nil {
 		return nil, err
 	}
 
@@ -302,7 +302,7 @@ func createNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, e
 }
 
 func createClassicNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -371,10 +371,12 @@ func createVpcNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string
 	return resp.NasVolumeInstanceList[0].NasVolumeInstanceNo, nil
 }
 
-func waitForNasVolumeCreation(d *schema.ResourceData, config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"INIT"},
-		Target:  []string{"CREAT"},
+func waitForNasVolumeCreation(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
+	waiter := &statusWaiter{
+		Name:    "NasVolumeInstance",
+		ID:      id,
+		Pending: []string{InstanceStatusInit},
+		Target:  []string{InstanceStatusCreated},
 		Refresh: func() (interface{}, string, error) {
 			instance, err := getNasVolume(config, id)
 
@@ -383,25 +385,19 @@ func waitForNasVolumeCreation(d *schema.ResourceData, config *ProviderConfig, id
 			}
 
 			if instance == nil {
-				return instance, "INIT", nil
+				return instance, InstanceStatusInit, nil
 			}
 
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for NasVolumeInstance state to be \"CREAT\": %s", err)
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func deleteNasVolume(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteNasVolume(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
@@ -414,7 +410,7 @@ func deleteNasVolume(d *schema.ResourceData, config *ProviderConfig, id string)
 		return err
 	}
 
-	if err := waitForNasVolumeDeletion(d, config, id); err != nil {
+	if err := waitForNasVolumeDeletion(ctx, d, config, id); err != nil {
 		return err
 	}
 
@@ -449,10 +445,12 @@ func deleteVpcNasVolume(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func waitForNasVolumeDeletion(d *schema.ResourceData, config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"INIT", "CREAT"},
-		Target:  []string{"TERMT"},
+func waitForNasVolumeDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
+	waiter := &statusWaiter{
+		Name:    "NasVolumeInstance",
+		ID:      id,
+		Pending: []string{InstanceStatusInit, InstanceStatusCreated},
+		Target:  []string{InstanceStatusTerminate},
 		Refresh: func() (interface{}, string, error) {
 			instance, err := getNasVolume(config, id)
 
@@ -461,22 +459,16 @@ func waitForNasVolumeDeletion(d *schema.ResourceData, config *ProviderConfig, id
 			}
 
 			if instance == nil { // Instance is terminated.
-				return instance, "TERMT", nil
+				return instance, InstanceStatusTerminate, nil
 			}
 
 			return instance, ncloud.StringValue(instance.Status), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for NasVolumeInstance state to be \"TERMT\": %s", err)
-	}
-
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
 func changeNasVolumeSize(d *schema.ResourceData, config *ProviderConfig) error {
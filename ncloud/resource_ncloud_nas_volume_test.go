@@ -320,3 +320,43 @@ resource "ncloud_nas_volume" "test" {
 	server_instance_no_list = [ncloud_server.server-foo.id,ncloud_server.server-bar.id]
 }`, volumeNamePostfix)
 }
+
+func TestResourceNcloudNasVolume_vpc_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_nas_volume"]
+
+	raw := map[string]interface{}{
+		"volume_name_postfix":            "emulator",
+		"volume_size":                    "500",
+		"volume_allotment_protocol_type": "NFS",
+	}
+	state := testEmulatorApply(t, r, nil, raw, config)
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":                           "n000001_emulator",
+		"volume_size":                    "500",
+		"volume_total_size":              "500",
+		"volume_allotment_protocol_type": "NFS",
+		"zone":                           "KR-1",
+	})
+
+	raw["volume_size"] = "600"
+	resized := testEmulatorApply(t, r, state, raw, config)
+	testCheckEmulatorState(t, resized, map[string]string{
+		"nas_volume_no": state.ID,
+		"volume_size":   "600",
+	})
+
+	imported := testEmulatorImport(t, r, state.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"volume_size": "600",
+	})
+
+	testEmulatorDestroy(t, r, resized, config)
+
+	if instance, err := getNasVolume(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected NAS volume to be deleted, got %v, %v", instance, err)
+	}
+}
@@ -1,12 +1,13 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudNetworkACL() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkACLCreate,
-		Read:   resourceNcloudNetworkACLRead,
-		Update: resourceNcloudNetworkACLUpdate,
-		Delete: resourceNcloudNetworkACLDelete,
+		CreateContext: resourceNcloudNetworkACLCreate,
+		ReadContext:   resourceNcloudNetworkACLRead,
+		UpdateContext: resourceNcloudNetworkACLUpdate,
+		DeleteContext: resourceNcloudNetworkACLDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,11 +57,11 @@ func resourceNcloudNetworkACL() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_network_acl`"))
 	}
 
 	reqParams := &vpc.CreateNetworkAclRequest{
@@ -80,7 +81,7 @@ func resourceNcloudNetworkACLCreate(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.CreateNetworkAcl(reqParams)
 	if err != nil {
 		logErrorResponse("CreateNetworkAcl", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("CreateNetworkAcl", resp)
@@ -89,19 +90,19 @@ func resourceNcloudNetworkACLCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(*instance.NetworkAclNo)
 	log.Printf("[INFO] Network ACL ID: %s", d.Id())
 
-	if err := waitForNcloudNetworkACLCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudNetworkACLCreation(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudNetworkACLRead(d, meta)
+	return resourceNcloudNetworkACLRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getNetworkACLInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -119,19 +120,19 @@ func resourceNcloudNetworkACLRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("description") {
 		if err := setNetworkACLDescription(d, config); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudNetworkACLRead(d, meta)
+	return resourceNcloudNetworkACLRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteNetworkAclRequest{
@@ -143,19 +144,19 @@ func resourceNcloudNetworkACLDelete(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.DeleteNetworkAcl(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteNetworkAcl", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("DeleteNetworkAcl", resp)
 
-	if err := waitForNcloudNetworkACLDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudNetworkACLDeletion(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLCreation(config *ProviderConfig, id string) error {
+func waitForNcloudNetworkACLCreation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
@@ -164,18 +165,18 @@ func waitForNcloudNetworkACLCreation(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
 		},
 		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Network ACL (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLDeletion(config *ProviderConfig, id string) error {
+func waitForNcloudNetworkACLDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
@@ -184,11 +185,11 @@ func waitForNcloudNetworkACLDeletion(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Network ACL (%s) to become termintaing: %s", id, err)
 	}
 
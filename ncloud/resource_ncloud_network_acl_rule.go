@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,10 +21,10 @@ func init() {
 
 func resourceNcloudNetworkACLRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkACLRuleCreate,
-		Read:   resourceNcloudNetworkACLRuleRead,
-		Update: resourceNcloudNetworkACLRuleUpdate,
-		Delete: resourceNcloudNetworkACLRuleDelete,
+		CreateContext: resourceNcloudNetworkACLRuleCreate,
+		ReadContext:   resourceNcloudNetworkACLRuleRead,
+		UpdateContext: resourceNcloudNetworkACLRuleUpdate,
+		DeleteContext: resourceNcloudNetworkACLRuleDelete,
 		Schema: map[string]*schema.Schema{
 			"network_acl_no": {
 				Type:     schema.TypeString,
@@ -123,20 +125,20 @@ func resourceNcloudNetworkACLRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl_rule`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_network_acl_rule`"))
 	}
 
 	d.SetId(d.Get("network_acl_no").(string))
 	log.Printf("[INFO] Network ACL ID: %s", d.Id())
 
-	return resourceNcloudNetworkACLRuleUpdate(d, meta)
+	return resourceNcloudNetworkACLRuleUpdate(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	rules, err := getNetworkACLRuleList(config, d.Id())
@@ -145,7 +147,7 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 		if errBody.ReturnCode == "1011002" { // You cannot access the appropriate Network ACL
 			d.SetId("")
 		}
-		return err
+		return diagFromErr(err)
 	}
 
 	if len(rules) == 0 {
@@ -192,46 +194,46 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceNcloudNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("inbound") {
-		if err := updateNetworkACLRule(d, config, "inbound"); err != nil {
-			return err
+		if err := updateNetworkACLRule(ctx, d, config, "inbound"); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("outbound") {
-		if err := updateNetworkACLRule(d, config, "outbound"); err != nil {
-			return err
+		if err := updateNetworkACLRule(ctx, d, config, "outbound"); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudNetworkACLRuleRead(d, meta)
+	return resourceNcloudNetworkACLRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	i := d.Get("inbound").(*schema.Set)
 	o := d.Get("outbound").(*schema.Set)
 
 	if len(i.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
-			return err
+		if err := removeNetworkACLRule(ctx, d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if len(o.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
-			return err
+		if err := removeNetworkACLRule(ctx, d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLRunning(config *ProviderConfig, id string) error {
+func waitForNcloudNetworkACLRunning(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
@@ -240,11 +242,11 @@ func waitForNcloudNetworkACLRunning(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Network ACL (%s) to become termintaing: %s", id, err)
 	}
 
@@ -268,7 +270,7 @@ func getNetworkACLRuleList(config *ProviderConfig, id string) ([]*vpc.NetworkAcl
 	return resp.NetworkAclRuleList, nil
 }
 
-func updateNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType string) error {
+func updateNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string) error {
 	o, n := d.GetChange(ruleType)
 
 	if o == nil {
@@ -288,13 +290,13 @@ func updateNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 	addNetworkACLRuleList := expandAddNetworkAclRule(add)
 
 	if len(removeNetworkACLRuleList) > 0 {
-		if err := removeNetworkACLRule(d, config, ruleType, removeNetworkACLRuleList); err != nil {
+		if err := removeNetworkACLRule(ctx, d, config, ruleType, removeNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
 
 	if len(addNetworkACLRuleList) > 0 {
-		if err := addNetworkACLRule(d, config, ruleType, addNetworkACLRuleList); err != nil {
+		if err := addNetworkACLRule(ctx, d, config, ruleType, addNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
@@ -302,11 +304,11 @@ func updateNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 	return nil
 }
 
-func addNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
+func addNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
 		if ruleType == "inbound" {
@@ -333,7 +335,7 @@ func addNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType
 			errBody, _ := GetCommonErrorBody(err)
 			if containsInStringList(errBody.ReturnCode, []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
 				logErrorResponse("retry AddNetworkAclRule", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -348,18 +350,18 @@ func addNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType
 
 	logResponse("AddNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
+func removeNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		if ruleType == "inbound" {
@@ -386,7 +388,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == ApiErrorNetworkAclCantAccessaApropriate {
 				logErrorResponse("retry RemoveNetworkAclRule", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -401,7 +403,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 
 	logResponse("RemoveNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
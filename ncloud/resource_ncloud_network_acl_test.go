@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -236,7 +237,7 @@ func testAccCheckNetworkACLDisappears(instance *vpc.NetworkAcl) resource.TestChe
 
 		_, err := config.Client.vpc.V2Api.DeleteNetworkAcl(reqParams)
 
-		if err := waitForNcloudNetworkACLDeletion(config, *instance.NetworkAclNo); err != nil {
+		if err := waitForNcloudNetworkACLDeletion(context.Background(), config, *instance.NetworkAclNo); err != nil {
 			return err
 		}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -26,12 +28,12 @@ const (
 
 func resourceNcloudNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkInterfaceCreate,
-		Read:   resourceNcloudNetworkInterfaceRead,
-		Update: resourceNcloudNetworkInterfaceUpdate,
-		Delete: resourceNcloudNetworkInterfaceDelete,
+		CreateContext: resourceNcloudNetworkInterfaceCreate,
+		ReadContext:   resourceNcloudNetworkInterfaceRead,
+		UpdateContext: resourceNcloudNetworkInterfaceUpdate,
+		DeleteContext: resourceNcloudNetworkInterfaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"subnet_no": {
@@ -90,33 +92,33 @@ func resourceNcloudNetworkInterface() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := createNetworkInterface(d, config)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*instance.NetworkInterfaceNo)
 	log.Printf("[INFO] Network Interface ID: %s", d.Id())
 
 	if v, ok := d.GetOk("server_instance_no"); ok && v != "" {
-		if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
-			return err
+		if err := waitForNetworkInterfaceAttachment(ctx, config, d.Id()); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudNetworkInterfaceRead(d, meta)
+	return resourceNcloudNetworkInterfaceRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getNetworkInterface(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -142,20 +144,24 @@ func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := detachNetworkInterface(d, config, o.(string)); err != nil {
-				return err
+			if err := detachNetworkInterface(ctx, config, d.Id(), d.Get("subnet_no").(string), o.(string)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachNetworkInterface(d, config); err != nil {
-				return err
+			if err := attachNetworkInterface(ctx, config, d.Id(), d.Get("subnet_no").(string), n.(string)); err != nil {
+				return diagFromErr(err)
+			}
+
+			if err := waitForPublicIpDisassociation(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -173,31 +179,31 @@ func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 
 		// First do add ACG prevent error '[1002035] At least one Acg must remain on the network interface.'
 		if len(addAcgList) > 0 {
-			if err := addNetworkInterfaceAccessControlGroup(d, config, addAcgList); err != nil {
-				return err
+			if err := addNetworkInterfaceAccessControlGroup(ctx, config, d.Id(), addAcgList); err != nil {
+				return diagFromErr(err)
 			}
 		}
 
 		if len(removeAcgList) > 0 {
-			if err := removeNetworkInterfaceAccessControlGroup(d, config, removeAcgList); err != nil {
-				return err
+			if err := removeNetworkInterfaceAccessControlGroup(ctx, config, d.Id(), removeAcgList, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
 
-	return resourceNcloudNetworkInterfaceRead(d, meta)
+	return resourceNcloudNetworkInterfaceRead(ctx, d, meta)
 }
 
-func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *ProviderConfig, accessControlGroupNoList []*string) error {
+func removeNetworkInterfaceAccessControlGroup(ctx context.Context, config *ProviderConfig, id string, accessControlGroupNoList []*string, timeout time.Duration) error {
 	var resp *vserver.RemoveNetworkInterfaceAccessControlGroupResponse
 	var reqParams *vserver.RemoveNetworkInterfaceAccessControlGroupRequest
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		var err error
 		reqParams = &vserver.RemoveNetworkInterfaceAccessControlGroupRequest{
 			RegionCode:               &config.RegionCode,
 			AccessControlGroupNoList: accessControlGroupNoList,
-			NetworkInterfaceNo:       ncloud.String(d.Id()),
+			NetworkInterfaceNo:       ncloud.String(id),
 		}
 
 		logCommonRequest("RemoveNetworkInterfaceAccessControlGroup", reqParams)
@@ -207,7 +213,7 @@ func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *Pr
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == ApiErrorNetworkInterfaceAtLeastOneAcgMustRemain {
 				logErrorResponse("retry RemoveNetworkInterfaceAccessControlGroup", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -222,18 +228,18 @@ func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *Pr
 
 	logResponse("RemoveNetworkInterfaceAccessControlGroup", resp)
 
-	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+	if err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func addNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *ProviderConfig, accessControlGroupNoList []*string) error {
+func addNetworkInterfaceAccessControlGroup(ctx context.Context, config *ProviderConfig, id string, accessControlGroupNoList []*string) error {
 	reqParams := &vserver.AddNetworkInterfaceAccessControlGroupRequest{
 		RegionCode:               &config.RegionCode,
 		AccessControlGroupNoList: accessControlGroupNoList,
-		NetworkInterfaceNo:       ncloud.String(d.Id()),
+		NetworkInterfaceNo:       ncloud.String(id),
 	}
 
 	logCommonRequest("AddNetworkInterfaceAccessControlGroup", reqParams)
@@ -246,18 +252,18 @@ func addNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *Provi
 
 	logResponse("AddNetworkInterfaceAccessControlGroup", resp)
 
-	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+	if err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func resourceNcloudNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	if err := deleteNetworkInterface(config, d.Id()); err != nil {
-		return err
+	if err := deleteNetworkInterface(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -332,15 +338,15 @@ func createVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig) (
 	return resp.NetworkInterfaceList[0], nil
 }
 
-func deleteNetworkInterface(config *ProviderConfig, id string) error {
+func deleteNetworkInterface(ctx context.Context, config *ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcNetworkInterface(config, id)
+		return deleteVpcNetworkInterface(ctx, config, id)
 	}
 
 	return NotSupportClassic("resource `ncloud_network_interface`")
 }
 
-func deleteVpcNetworkInterface(config *ProviderConfig, id string) error {
+func deleteVpcNetworkInterface(ctx context.Context, config *ProviderConfig, id string) error {
 	reqParams := &vserver.DeleteNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(id),
@@ -354,78 +360,58 @@ func deleteVpcNetworkInterface(config *ProviderConfig, id string) error {
 	}
 	logResponse("deleteVpcNetworkInterface", resp)
 
-	if err := waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateUsed, NetworkInterfaceStateNotUsed, NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateTerminated}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateUsed, NetworkInterfaceStateNotUsed, NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateTerminated}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachNetworkInterface(d *schema.ResourceData, config *ProviderConfig) error {
-	var err error
-
+func attachNetworkInterface(ctx context.Context, config *ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	if config.SupportVPC {
-		err = attachVpcNetworkInterface(d, config)
-	} else {
-		err = NotSupportClassic("resource `ncloud_network_interface`")
-	}
-
-	if err != nil {
-		return err
-	}
-
-	if err := waitForPublicIpDisassociation(config, d.Id()); err != nil {
-		return err
+		return attachVpcNetworkInterface(ctx, config, id, subnetNo, serverInstanceNo)
 	}
 
-	return nil
+	return NotSupportClassic("resource `ncloud_network_interface`")
 }
 
-func attachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig) error {
+func attachVpcNetworkInterface(ctx context.Context, config *ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	reqParams := &vserver.AttachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
-		NetworkInterfaceNo: ncloud.String(d.Id()),
-		SubnetNo:           ncloud.String(d.Get("subnet_no").(string)),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		NetworkInterfaceNo: ncloud.String(id),
+		SubnetNo:           ncloud.String(subnetNo),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	logCommonRequest("attachVpcNetworkInterface", reqParams)
 
 	resp, err := config.Client.vserver.V2Api.AttachNetworkInterface(reqParams)
 	if err != nil {
-		logErrorResponse("attachVpcNetworkInterface", err, d.Id())
+		logErrorResponse("attachVpcNetworkInterface", err, id)
 		return err
 	}
 	logCommonResponse("attachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
+	if err := waitForNetworkInterfaceAttachment(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func detachNetworkInterface(d *schema.ResourceData, config *ProviderConfig, serverInstanceNo string) error {
-	var err error
-
+func detachNetworkInterface(ctx context.Context, config *ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	if config.SupportVPC {
-		err = detachVpcNetworkInterface(d, config, serverInstanceNo)
-	} else {
-		err = NotSupportClassic("resource `ncloud_network_interface`")
+		return detachVpcNetworkInterface(ctx, config, id, subnetNo, serverInstanceNo)
 	}
 
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return NotSupportClassic("resource `ncloud_network_interface`")
 }
 
-func detachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig, serverInstanceNo string) error {
+func detachVpcNetworkInterface(ctx context.Context, config *ProviderConfig, id string, subnetNo string, serverInstanceNo string) error {
 	reqParams := &vserver.DetachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
-		NetworkInterfaceNo: ncloud.String(d.Id()),
-		SubnetNo:           ncloud.String(d.Get("subnet_no").(string)),
+		NetworkInterfaceNo: ncloud.String(id),
+		SubnetNo:           ncloud.String(subnetNo),
 		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
@@ -433,23 +419,23 @@ func detachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig, s
 
 	resp, err := config.Client.vserver.V2Api.DetachNetworkInterface(reqParams)
 	if err != nil {
-		logErrorResponse("detachVpcNetworkInterface", err, d.Id())
+		logErrorResponse("detachVpcNetworkInterface", err, id)
 		return err
 	}
 	logCommonResponse("detachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForNetworkInterfaceAttachment(config *ProviderConfig, id string) error {
+func waitForNetworkInterfaceAttachment(ctx context.Context, config *ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateUsed})
+		err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateUsed})
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -461,7 +447,7 @@ func waitForNetworkInterfaceAttachment(config *ProviderConfig, id string) error
 	return nil
 }
 
-func waitForVpcNetworkInterfaceState(config *ProviderConfig, id string, pending []string, target []string) error {
+func waitForVpcNetworkInterfaceState(ctx context.Context, config *ProviderConfig, id string, pending []string, target []string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
@@ -470,11 +456,11 @@ func waitForVpcNetworkInterfaceState(config *ProviderConfig, id string, pending
 			return VpcCommonStateRefreshFunc(instance, err, "NetworkInterfaceStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Network Interface (%s) to become (%v): %s", id, target, err)
 	}
@@ -2,11 +2,9 @@ package ncloud
 
 import (
 	"context"
-	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -124,7 +122,7 @@ func resourceNcloudNKSCluster() *schema.Resource {
 func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
 	}
 
 	reqParams := &vnks.ClusterInputBody{
@@ -153,13 +151,13 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 	resp, err := config.Client.vnks.V2Api.ClustersPost(ctx, reqParams)
 	if err != nil {
 		logErrorResponse("resourceNcloudNKSClusterCreate", err, reqParams)
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	uuid := ncloud.StringValue(resp.Uuid)
 
 	logResponse("resourceNcloudNKSClusterCreate", resp)
-	if err := waitForNKSClusterActive(ctx, d, config, uuid); err != nil {
-		return diag.FromErr(err)
+	if err := waitForNKSClusterActive(ctx, config, uuid, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diagFromErr(err)
 	}
 	d.SetId(uuid)
 	return resourceNcloudNKSClusterRead(ctx, d, meta)
@@ -168,12 +166,12 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 func resourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
 	}
 
 	cluster, err := getNKSCluster(ctx, config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if cluster == nil {
@@ -206,28 +204,30 @@ func resourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
 	}
 
-	if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
-		return diag.FromErr(err)
+	if err := waitForNKSClusterActive(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
 	}
 
 	logCommonRequest("resourceNcloudNKSClusterDelete", d.Id())
 	if err := config.Client.vnks.V2Api.ClustersUuidDelete(ctx, ncloud.String(d.Id())); err != nil {
 		logErrorResponse("resourceNcloudNKSClusterDelete", err, d.Id())
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := waitForNKSClusterDeletion(ctx, d, config); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
 func waitForNKSClusterDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &statusWaiter{
+		Name:    "NKS Cluster",
+		ID:      d.Id(),
 		Pending: []string{NKSStatusDeletingCode},
 		Target:  []string{NKSStatusNullCode},
 		Refresh: func() (result interface{}, state string, err error) {
@@ -240,18 +240,17 @@ func waitForNKSClusterDeletion(ctx context.Context, d *schema.ResourceData, conf
 			}
 			return cluster, ncloud.StringValue(cluster.Status), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("Error waiting for NKS Cluster (%s) to become terminating: %s", d.Id(), err)
-	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForNKSClusterActive(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, uuid string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNKSClusterActive(ctx context.Context, config *ProviderConfig, uuid string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "NKS Cluster",
+		ID:      uuid,
 		Pending: []string{NKSStatusCreatingCode, NKSStatusWorkingCode},
 		Target:  []string{NKSStatusRunningCode, NKSStatusNoNodeCode},
 		Refresh: func() (result interface{}, state string, err error) {
@@ -265,14 +264,11 @@ func waitForNKSClusterActive(ctx context.Context, d *schema.ResourceData, config
 			return cluster, ncloud.StringValue(cluster.Status), nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: timeout,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("error waiting for NKS Cluster (%s) to become activating: %s", uuid, err)
-	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
 func getNKSCluster(ctx context.Context, config *ProviderConfig, uuid string) (*vnks.Cluster, error) {
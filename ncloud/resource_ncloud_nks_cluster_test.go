@@ -245,3 +245,50 @@ func getTestClusterName() string {
 	testClusterName := fmt.Sprintf("tf-%d-cluster", rInt)
 	return testClusterName
 }
+
+func TestResourceNcloudNKSCluster_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_nks_cluster"]
+
+	vpcNo, subnetNo := e.seedVpc("10.2.0.0/16", "10.2.1.0/24")
+	lbSubnetNo := e.seedSubnet(vpcNo, "10.2.100.0/24", "PRIVATE", "LOADB")
+	loginKey := testEmulatorApply(t, p.ResourcesMap["ncloud_login_key"], nil, map[string]interface{}{
+		"key_name": "tf-emulator-key",
+	}, config)
+
+	raw := map[string]interface{}{
+		"name":                 "tf-emulator-cluster",
+		"cluster_type":         "SVR.VNKS.STAND.C002.M008.NET.SSD.B050.G002",
+		"k8s_version":          "1.20.13-nks.1",
+		"login_key_name":       loginKey.ID,
+		"lb_private_subnet_no": lbSubnetNo,
+		"kube_network_plugin":  "cilium",
+		"subnet_no_list":       []interface{}{subnetNo},
+		"vpc_no":               vpcNo,
+		"zone":                 "KR-1",
+	}
+	state := testEmulatorApply(t, r, nil, raw, config)
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":                 "tf-emulator-cluster",
+		"uuid":                 state.ID,
+		"vpc_no":               vpcNo,
+		"lb_private_subnet_no": lbSubnetNo,
+		"subnet_no_list.#":     "1",
+		"login_key_name":       loginKey.ID,
+	})
+
+	imported := testEmulatorImport(t, r, state.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"name":   "tf-emulator-cluster",
+		"vpc_no": vpcNo,
+	})
+
+	testEmulatorDestroy(t, r, state, config)
+
+	if cluster, err := getNKSClusterFromList(context.Background(), config, state.ID); err != nil || cluster != nil {
+		t.Fatalf("Expected cluster to be deleted, got %v, %v", cluster, err)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -102,7 +101,7 @@ func resourceNcloudNKSNodePool() *schema.Resource {
 func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
 	}
 
 	clusterUuid := d.Get("cluster_uuid").(string)
@@ -124,12 +123,12 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 	err := config.Client.vnks.V2Api.ClustersUuidNodePoolPost(ctx, reqParams, ncloud.String(clusterUuid))
 	if err != nil {
 		logErrorResponse("resourceNcloudNKSNodePoolCreate", err, reqParams)
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	logResponse("resourceNcloudNKSNodePoolCreate", reqParams)
-	if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
-		return diag.FromErr(err)
+	if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, ncloud.StringValue(reqParams.Name), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId(id)
@@ -139,13 +138,13 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 func resourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
 	}
 
 	clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
 	nodePool, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if nodePool == nil {
@@ -170,19 +169,19 @@ func resourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData,
 func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
 	}
 
 	clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	instanceNo := StringPtrOrNil(d.GetOk("instance_no"))
 
 	if d.HasChanges("node_count", "autoscale") {
-		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
-			return diag.FromErr(err)
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diagFromErr(err)
 		}
 		reqParams := &vnks.NodePoolUpdateBody{
 			NodeCount: Int32PtrOrNil(d.GetOk("node_count")),
@@ -195,12 +194,12 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 		err := config.Client.vnks.V2Api.ClustersUuidNodePoolInstanceNoPatch(ctx, reqParams, ncloud.String(clusterUuid), instanceNo)
 		if err != nil {
 			logErrorResponse("resourceNcloudNKSNodePoolUpdate", err, reqParams)
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		logResponse("resourceNcloudNKSNodePoolUpdate", reqParams)
-		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
-			return diag.FromErr(err)
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	return resourceNcloudNKSNodePoolRead(ctx, d, config)
@@ -209,34 +208,36 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 func resourceNcloudNKSNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
-		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
+		return diagFromErr(NotSupportClassic("resource `ncloud_nks_node_pool`"))
 	}
 
 	clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	instanceNo := StringPtrOrNil(d.GetOk("instance_no"))
-	if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
-		return diag.FromErr(err)
+	if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
 	}
 
 	logCommonRequest("resourceNcloudNKSNodePoolDelete", d.Id())
 	if err := config.Client.vnks.V2Api.ClustersUuidNodePoolInstanceNoDelete(ctx, ncloud.String(clusterUuid), instanceNo); err != nil {
 		logErrorResponse("resourceNcloudNKSNodePoolDelete", err, instanceNo)
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := waitForNKSNodePoolDeletion(ctx, d, config); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
 func waitForNKSNodePoolDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &statusWaiter{
+		Name:    "NKS NodePool",
+		ID:      d.Id(),
 		Pending: []string{NKSNodePoolStatusNodeScaleDown, NKSStatusDeletingCode},
 		Target:  []string{NKSStatusNullCode},
 		Refresh: func() (result interface{}, state string, err error) {
@@ -258,18 +259,17 @@ func waitForNKSNodePoolDeletion(ctx context.Context, d *schema.ResourceData, con
 			return np, ncloud.StringValue(np.Status), nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("Error waiting for NKS NodePool (%s) to become terminating: %s", d.Id(), err)
-	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func waitForNKSNodePoolActive(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, clusterUuid string, nodePoolName string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNKSNodePoolActive(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "NKS NodePool",
+		ID:      nodePoolName,
 		Pending: []string{NKSStatusCreatingCode, NKSNodePoolStatusNodeScaleOut, NKSNodePoolStatusNodeScaleDown},
 		Target:  []string{NKSNodePoolStatusRunCode},
 		Refresh: func() (result interface{}, state string, err error) {
@@ -283,14 +283,11 @@ func waitForNKSNodePoolActive(ctx context.Context, d *schema.ResourceData, confi
 			return np, ncloud.StringValue(np.Status), nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: timeout,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("error waiting for NKS NodePool (%s) to become activating: %s", nodePoolName, err)
-	}
-	return nil
+
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
 func getNKSNodePool(ctx context.Context, config *ProviderConfig, uuid string, nodePoolName string) (*vnks.NodePoolRes, error) {
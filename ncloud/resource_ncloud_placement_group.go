@@ -1,10 +1,12 @@
 package ncloud
 
 import (
+	"context"
 	"log"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -15,11 +17,11 @@ func init() {
 
 func resourceNcloudPlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudPlacementGroupCreate,
-		Read:   resourceNcloudPlacementGroupRead,
-		Delete: resourceNcloudPlacementGroupDelete,
+		CreateContext: resourceNcloudPlacementGroupCreate,
+		ReadContext:   resourceNcloudPlacementGroupRead,
+		DeleteContext: resourceNcloudPlacementGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -44,11 +46,11 @@ func resourceNcloudPlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudPlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_placement_group`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_placement_group`"))
 	}
 
 	reqParams := &vserver.CreatePlacementGroupRequest{
@@ -67,7 +69,7 @@ func resourceNcloudPlacementGroupCreate(d *schema.ResourceData, meta interface{}
 	resp, err := config.Client.vserver.V2Api.CreatePlacementGroup(reqParams)
 	if err != nil {
 		logErrorResponse("CreatePlacementGroup", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("CreatePlacementGroup", resp)
@@ -77,15 +79,15 @@ func resourceNcloudPlacementGroupCreate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[INFO] Placement Group ID: %s", d.Id())
 
-	return resourceNcloudPlacementGroupRead(d, meta)
+	return resourceNcloudPlacementGroupRead(ctx, d, meta)
 }
 
-func resourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getPlacementGroupInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -101,7 +103,7 @@ func resourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceNcloudPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vserver.DeletePlacementGroupRequest{
@@ -113,7 +115,7 @@ func resourceNcloudPlacementGroupDelete(d *schema.ResourceData, meta interface{}
 	resp, err := config.Client.vserver.V2Api.DeletePlacementGroup(reqParams)
 	if err != nil {
 		logErrorResponse("DeletePlacementGroup", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("DeletePlacementGroup", resp)
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,11 +22,10 @@ func init() {
 
 func resourceNcloudPortForwadingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudPortForwardingRuleCreate,
-		Read:   resourceNcloudPortForwardingRuleRead,
-		Update: resourceNcloudPortForwardingRuleUpdate,
-		Delete: resourceNcloudPortForwardingRuleDelete,
-		Exists: resourceNcloudPortForwardingRuleExists,
+		CreateContext: resourceNcloudPortForwardingRuleCreate,
+		ReadContext:   resourceNcloudPortForwardingRuleRead,
+		UpdateContext: resourceNcloudPortForwardingRuleUpdate,
+		DeleteContext: resourceNcloudPortForwardingRuleDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -73,12 +74,12 @@ func resourceNcloudPortForwadingRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var portForwardingExternalPort int32
@@ -107,7 +108,7 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 	}
 
 	var resp *server.AddPortForwardingRulesResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 		logCommonRequest("AddPortForwardingRules", reqParams)
 		resp, err = config.Client.server.V2Api.AddPortForwardingRules(reqParams)
@@ -115,7 +116,7 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 			errBody, _ := GetCommonErrorBody(err)
 			if containsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
 				logErrorResponse("retry AddPortForwardingRules", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -126,19 +127,19 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		logErrorResponse("AddPortForwardingRules", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(newPortForwardingRuleId)
-	return resourceNcloudPortForwardingRuleRead(d, meta)
+	return resourceNcloudPortForwardingRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	_, zoneNo, portForwardingExternalPort := parsePortForwardingRuleId(d.Id())
 	resp, err := getPortForwardingRuleList(client, zoneNo)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var portForwardingRule *server.PortForwardingRule
@@ -166,30 +167,16 @@ func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceNcloudPortForwardingRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	config := meta.(*ProviderConfig)
-
-	zoneNo, err := getServerZoneNo(config, d.Get("server_instance_no").(string))
-	if err != nil {
-		return false, err
-	}
-	var portForwardingExternalPort int32
-	if v, ok := d.GetOk("port_forwarding_external_port"); ok {
-		portForwardingExternalPort = int32(v.(int))
-	}
-	return hasPortForwardingRule(config.Client, zoneNo, portForwardingExternalPort)
-}
-
-func resourceNcloudPortForwardingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudPortForwardingRuleRead(d, meta)
+func resourceNcloudPortForwardingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudPortForwardingRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var portForwardingExternalPort int32
 	if v, ok := d.GetOk("port_forwarding_external_port"); ok {
@@ -213,7 +200,7 @@ func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interfa
 	}
 
 	var resp *server.DeletePortForwardingRulesResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 		logCommonRequest("DeletePortForwardingRules", reqParams)
 		resp, err = client.server.V2Api.DeletePortForwardingRules(reqParams)
@@ -221,7 +208,7 @@ func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interfa
 			errBody, _ := GetCommonErrorBody(err)
 			if containsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
 				logErrorResponse("retry DeletePortForwardingRules", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -232,7 +219,7 @@ func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		logErrorResponse("DeletePortForwardingRules", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -269,7 +256,7 @@ func getPortForwardingConfigurationNo(d *schema.ResourceData, meta interface{})
 
 func getPortForwardingConfigurationList(d *schema.ResourceData, config *ProviderConfig) (*server.GetPortForwardingConfigurationListResponse, error) {
 	reqParams := &server.GetPortForwardingConfigurationListRequest{
-		RegionNo:             ncloud.String(config.RegionNo),
+		RegionNo:             providerRegionNo(config),
 		ServerInstanceNoList: []*string{ncloud.String(d.Get("server_instance_no").(string))},
 	}
 	logCommonRequest("GetPortForwardingConfigurationList", reqParams)
@@ -310,11 +297,3 @@ func getPortForwardingRule(client *NcloudAPIClient, zoneNo string, portForwardin
 	}
 	return nil, nil
 }
-
-func hasPortForwardingRule(client *NcloudAPIClient, zoneNo string, portForwardingExternalPort int32) (bool, error) {
-	rule, _ := getPortForwardingRule(client, zoneNo, portForwardingExternalPort)
-	if rule != nil {
-		return true, nil
-	}
-	return false, nil
-}
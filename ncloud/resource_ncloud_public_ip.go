@@ -9,7 +9,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -20,12 +20,17 @@ func init() {
 
 func resourceNcloudPublicIpInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudPublicIpCreate,
-		Read:   resourceNcloudPublicIpRead,
-		Update: resourceNcloudPublicIpUpdate,
-		Delete: resourceNcloudPublicIpDelete,
+		CreateContext: resourceNcloudPublicIpCreate,
+		ReadContext:   resourceNcloudPublicIpRead,
+		UpdateContext: resourceNcloudPublicIpUpdate,
+		DeleteContext: resourceNcloudPublicIpDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout),
+			Update: schema.DefaultTimeout(DefaultTimeout),
+			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
 		CustomizeDiff: resourceNcloudPublicIpCustomizeDiff,
 		Schema: map[string]*schema.Schema{
@@ -77,7 +82,7 @@ func resourceNcloudPublicIpInstance() *schema.Resource {
 	}
 }
 
-func resourceNcloudPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	var publicIpInstanceNo *string
 	var err error
@@ -89,28 +94,28 @@ func resourceNcloudPublicIpCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(publicIpInstanceNo))
 	log.Printf("[INFO] Public IP ID: %s", d.Id())
 
 	if v, ok := d.GetOk("server_instance_no"); ok && v != "" {
-		if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
-			return err
+		if err := waitForPublicIpAssociation(ctx, config, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudPublicIpRead(d, meta)
+	return resourceNcloudPublicIpRead(ctx, d, meta)
 }
 
-func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	resource, err := getPublicIp(config, d.Id())
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if resource == nil {
@@ -121,24 +126,24 @@ func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error
 	instance := ConvertToMap(resource)
 	SetSingularResourceDataFromMapSchema(resourceNcloudPublicIpInstance(), d, instance)
 	if err := d.Set("public_ip_no", resource.PublicIpInstanceNo); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNcloudPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	var err error
 
 	// Check associated public ip
 	if associated, err := checkAssociatedPublicIP(config, d.Id()); associated {
 		// if associated public ip, disassociated the public ip
-		if err := disassociatedPublicIp(config, d.Id()); err != nil {
-			return err
+		if err := disassociatedPublicIp(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diagFromErr(err)
 		}
 	} else if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if config.SupportVPC {
@@ -148,32 +153,32 @@ func resourceNcloudPublicIpDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPublicIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := disassociatedPublicIp(config, d.Id()); err != nil {
-				return err
+			if err := disassociatedPublicIp(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diagFromErr(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := associatedPublicIp(d, config); err != nil {
-				return err
+			if err := associatedPublicIp(ctx, d, config); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
 
-	return resourceNcloudPublicIpRead(d, meta)
+	return resourceNcloudPublicIpRead(ctx, d, meta)
 }
 
 func createClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -185,7 +190,7 @@ func createClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) (*str
 	}
 
 	reqParams := &server.CreatePublicIpInstanceRequest{
-		RegionNo:            &config.RegionNo,
+		RegionNo:            providerRegionNo(config),
 		ZoneNo:              zoneNo,
 		ServerInstanceNo:    StringPtrOrNil(d.GetOk("server_instance_no")),
 		PublicIpDescription: StringPtrOrNil(d.GetOk("description")),
@@ -285,31 +290,39 @@ func getPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
 
 func getClassicPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo := providerRegionNo(config)
 
 	reqParams := &server.GetPublicIpInstanceListRequest{
-		RegionNo:               &regionNo,
+		RegionNo:               regionNo,
 		PublicIpInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	logCommonRequest("getClassicPublicIp", reqParams)
-	resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
+	var publicIpInstanceList []*server.PublicIpInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicPublicIp", reqParams)
+		resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicPublicIp", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicPublicIp", resp)
 
+		publicIpInstanceList = append(publicIpInstanceList, resp.PublicIpInstanceList...)
+		return len(resp.PublicIpInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicPublicIp", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicPublicIp", resp)
 
-	if len(resp.PublicIpInstanceList) == 0 {
+	if len(publicIpInstanceList) == 0 {
 		return nil, nil
 	}
 
-	if err := validateOneResult(len(resp.PublicIpInstanceList)); err != nil {
+	if err := validateOneResult(len(publicIpInstanceList)); err != nil {
 		return nil, err
 	}
 
-	r := resp.PublicIpInstanceList[0]
+	r := publicIpInstanceList[0]
 
 	p := &PublicIpInstance{
 		PublicIpInstanceNo:            r.PublicIpInstanceNo,
@@ -338,24 +351,32 @@ func getVpcPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error
 		PublicIpInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	logCommonRequest("getVpcPublicIp", reqParams)
-	resp, err := client.vserver.V2Api.GetPublicIpInstanceList(reqParams)
+	var publicIpInstanceList []*vserver.PublicIpInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getVpcPublicIp", reqParams)
+		resp, err := client.vserver.V2Api.GetPublicIpInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getVpcPublicIp", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getVpcPublicIp", resp)
 
+		publicIpInstanceList = append(publicIpInstanceList, resp.PublicIpInstanceList...)
+		return len(resp.PublicIpInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getVpcPublicIp", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcPublicIp", resp)
 
-	if len(resp.PublicIpInstanceList) == 0 {
+	if len(publicIpInstanceList) == 0 {
 		return nil, nil
 	}
 
-	if err := validateOneResult(len(resp.PublicIpInstanceList)); err != nil {
+	if err := validateOneResult(len(publicIpInstanceList)); err != nil {
 		return nil, err
 	}
 
-	r := resp.PublicIpInstanceList[0]
+	r := publicIpInstanceList[0]
 
 	p := &PublicIpInstance{
 		PublicIpInstanceNo:            r.PublicIpInstanceNo,
@@ -382,7 +403,7 @@ func checkAssociatedPublicIP(config *ProviderConfig, id string) (bool, error) {
 	return instance.ServerInstanceNo != nil && *instance.ServerInstanceNo != "", nil
 }
 
-func disassociatedPublicIp(config *ProviderConfig, id string) error {
+func disassociatedPublicIp(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
 	var err error
 
 	if config.SupportVPC {
@@ -395,7 +416,7 @@ func disassociatedPublicIp(config *ProviderConfig, id string) error {
 		return err
 	}
 
-	if err := waitForPublicIpDisassociation(config, id); err != nil {
+	if err := waitForPublicIpDisassociation(ctx, config, id, timeout); err != nil {
 		return err
 	}
 
@@ -432,35 +453,35 @@ func disassociatedVpcPublicIp(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func waitForPublicIpDisassociation(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForPublicIpDisassociation(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "Public IP",
+		ID:      id,
+		Field:   "disassociation",
 		Pending: []string{"NOT OK"},
 		Target:  []string{"OK"},
 		Refresh: func() (interface{}, string, error) {
 			isAssociated, err := checkAssociatedPublicIP(config, id)
-			opCode, opErr := getPublicIpInstanceOperationCode(config, id)
+			if err != nil {
+				return 0, "", err
+			}
 
-			if err != nil || opErr != nil {
+			opCode, err := getPublicIpInstanceOperationCode(config, id)
+			if err != nil {
 				return 0, "", err
 			}
 
-			if !isAssociated && opCode == "NULL" {
+			if !isAssociated && opCode == InstanceOperationNull {
 				return 0, "OK", nil
 			}
 
-			return nil, "NOT OK", nil
+			return 0, "NOT OK", nil
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for Public IP (%s) to become disassociation: %s", id, err)
+		Timeout: timeout,
 	}
 
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
 func getPublicIpInstanceOperationCode(config *ProviderConfig, id string) (string, error) {
@@ -471,8 +492,11 @@ func getPublicIpInstanceOperationCode(config *ProviderConfig, id string) (string
 	return *instance.PublicIpInstanceOperationCode, nil
 }
 
-func waitForPublicIpAssociation(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForPublicIpAssociation(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "Public IP",
+		ID:      id,
+		Field:   "association",
 		Pending: []string{"NOT OK"},
 		Target:  []string{"OK"},
 		Refresh: func() (interface{}, string, error) {
@@ -486,22 +510,16 @@ func waitForPublicIpAssociation(config *ProviderConfig, id string) error {
 				return 0, "OK", nil
 			}
 
-			return nil, "NOT OK", nil
+			return 0, "NOT OK", nil
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for Public IP (%s) to become association: %s", id, err)
-	}
-
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func associatedPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
+func associatedPublicIp(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	var err error
 
 	if config.SupportVPC {
@@ -514,7 +532,7 @@ func associatedPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
 		return err
 	}
 
-	if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
+	if err := waitForPublicIpAssociation(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package ncloud
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,12 +22,12 @@ func init() {
 
 func resourceNcloudRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteCreate,
-		Read:   resourceNcloudRouteRead,
-		Update: resourceNcloudRouteUpdate,
-		Delete: resourceNcloudRouteDelete,
+		CreateContext: resourceNcloudRouteCreate,
+		ReadContext:   resourceNcloudRouteRead,
+		UpdateContext: resourceNcloudRouteUpdate,
+		DeleteContext: resourceNcloudRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), ":")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("unexpected format of ID (%q), expected ROUTE_TABLE_NO:DESTINATION_CIDR_BLOCK", d.Id())
@@ -78,20 +80,20 @@ func resourceNcloudRoute() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `resource_ncloud_route`")
+		return diagFromErr(NotSupportClassic("resource `resource_ncloud_route`"))
 	}
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diagFromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	routeParams := &vpc.RouteParameter{
@@ -109,7 +111,7 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var resp *vpc.AddRouteResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
 		logCommonRequest("AddRoute", reqParams)
@@ -119,7 +121,7 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == "1017013" {
 				logErrorResponse("retry add Route", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -129,7 +131,7 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		logErrorResponse("AddRoute", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("AddRoute", resp)
@@ -138,19 +140,19 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Route ID: %s", d.Id())
 
-	if err := waitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := waitForNcloudRouteTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudRouteRead(d, meta)
+	return resourceNcloudRouteRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if routeTable != nil {
@@ -166,7 +168,7 @@ func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
 		if errBody.ReturnCode == "1017007" { // Route Table was not found
 			d.SetId("")
 		}
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -185,11 +187,11 @@ func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceNcloudRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudRouteRead(d, meta)
+func resourceNcloudRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudRouteRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	routeParams := &vpc.RouteParameter{
@@ -207,7 +209,7 @@ func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var resp *vpc.RemoveRouteResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		logCommonRequest("RemoveRoute", reqParams)
@@ -217,7 +219,7 @@ func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == "1017013" {
 				logErrorResponse("retry remove Route", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -227,19 +229,19 @@ func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		logErrorResponse("RemoveRoute", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("RemoveRoute", resp)
 
-	if err := waitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := waitForNcloudRouteTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudRouteTableUpdate(config *ProviderConfig, id string) error {
+func waitForNcloudRouteTableUpdate(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
@@ -248,11 +250,11 @@ func waitForNcloudRouteTableUpdate(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
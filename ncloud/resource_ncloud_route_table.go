@@ -1,12 +1,13 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteTableCreate,
-		Read:   resourceNcloudRouteTableRead,
-		Update: resourceNcloudRouteTableUpdate,
-		Delete: resourceNcloudRouteTableDelete,
+		CreateContext: resourceNcloudRouteTableCreate,
+		ReadContext:   resourceNcloudRouteTableRead,
+		UpdateContext: resourceNcloudRouteTableUpdate,
+		DeleteContext: resourceNcloudRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -62,11 +63,11 @@ func resourceNcloudRouteTable() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_route_table`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_route_table`"))
 	}
 
 	reqParams := &vpc.CreateRouteTableRequest{
@@ -87,7 +88,7 @@ func resourceNcloudRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.CreateRouteTable(reqParams)
 	if err != nil {
 		logErrorResponse("CreateRouteTable", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("CreateRouteTable", resp)
@@ -97,19 +98,19 @@ func resourceNcloudRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Route Table ID: %s", d.Id())
 
-	if err := waitForNcloudRouteTableCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudRouteTableCreation(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudRouteTableRead(d, meta)
+	return resourceNcloudRouteTableRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getRouteTableInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -128,19 +129,19 @@ func resourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("description") {
 		if err := setRouteTableDescription(d, config); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudRouteTableRead(d, meta)
+	return resourceNcloudRouteTableRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteRouteTableRequest{
@@ -152,19 +153,19 @@ func resourceNcloudRouteTableDelete(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.DeleteRouteTable(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteRouteTable", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("DeleteRouteTable", resp)
 
-	if err := waitForNcloudRouteTableDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudRouteTableDeletion(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudRouteTableCreation(config *ProviderConfig, id string) error {
+func waitForNcloudRouteTableCreation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
@@ -173,18 +174,18 @@ func waitForNcloudRouteTableCreation(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudRouteTableDeletion(config *ProviderConfig, id string) error {
+func waitForNcloudRouteTableDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
@@ -193,11 +194,11 @@ func waitForNcloudRouteTableDeletion(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become termintaing: %s", id, err)
 	}
 
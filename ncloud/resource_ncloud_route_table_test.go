@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -238,7 +239,7 @@ func testAccCheckRouteTableDisappears(instance *vpc.RouteTable) resource.TestChe
 
 		_, err := config.Client.vpc.V2Api.DeleteRouteTable(reqParams)
 
-		if err := waitForNcloudRouteTableDeletion(config, *instance.RouteTableNo); err != nil {
+		if err := waitForNcloudRouteTableDeletion(context.Background(), config, *instance.RouteTableNo); err != nil {
 			return err
 		}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -211,7 +212,7 @@ func testAccCheckRouteDisappears(instance *vpc.Route) resource.TestCheckFunc {
 
 		_, err = config.Client.vpc.V2Api.RemoveRoute(reqParams)
 
-		if err := waitForNcloudRouteTableUpdate(config, *instance.RouteTableNo); err != nil {
+		if err := waitForNcloudRouteTableUpdate(context.Background(), config, *instance.RouteTableNo); err != nil {
 			return err
 		}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"log"
@@ -10,28 +11,40 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	ServerDesiredStateRunning = "RUNNING"
+	ServerDesiredStateStopped = "STOPPED"
+)
+
 func init() {
 	RegisterResource("ncloud_server", resourceNcloudServer())
 }
 
 func resourceNcloudServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudServerCreate,
-		Read:   resourceNcloudServerRead,
-		Update: resourceNcloudServerUpdate,
-		Delete: resourceNcloudServerDelete,
+		CreateContext: resourceNcloudServerCreate,
+		ReadContext:   resourceNcloudServerRead,
+		UpdateContext: resourceNcloudServerUpdate,
+		DeleteContext: resourceNcloudServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(DefaultTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
+		CustomizeDiff: customdiff.All(
+			ncloudTagsAllCustomizeDiff,
+			resourceNcloudServerCustomizeDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"server_image_product_code": {
 				Type:          schema.TypeString,
@@ -102,8 +115,8 @@ func resourceNcloudServer() *schema.Resource {
 			"access_control_group_configuration_no_list": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true,
 				MinItems: 1,
 			},
 			"user_data": {
@@ -124,16 +137,15 @@ func resourceNcloudServer() *schema.Resource {
 						"tag_key": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"tag_value": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 					},
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"subnet_no": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -161,12 +173,10 @@ func resourceNcloudServer() *schema.Resource {
 						"network_interface_no": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"order": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"subnet_no": {
 							Type:     schema.TypeString,
@@ -179,6 +189,12 @@ func resourceNcloudServer() *schema.Resource {
 					},
 				},
 			},
+			"desired_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{ServerDesiredStateRunning, ServerDesiredStateStopped}, false)),
+			},
 			"is_encrypted_base_block_storage_volume": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -210,6 +226,10 @@ func resourceNcloudServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"public_ip": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -256,27 +276,34 @@ func resourceNcloudServer() *schema.Resource {
 	}
 }
 
-func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	id, err := createServerInstance(d, config)
+	id, err := createServerInstance(ctx, d, config)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Server instance ID: %s", d.Id())
 
-	return resourceNcloudServerRead(d, meta)
+	if d.Get("desired_state").(string) == ServerDesiredStateStopped {
+		log.Printf("[INFO] Stopping Instance %q for desired_state", d.Id())
+		if err := stopThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return resourceNcloudServerRead(ctx, d, meta)
 }
 
-func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	r, err := getServerInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if r == nil {
@@ -286,6 +313,13 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 
 	if config.SupportVPC {
 		buildNetworkInterfaceList(config, r)
+		setServerAccessControlGroups(d, r)
+	} else {
+		d.Set("tags_all", flattenInstanceTagMap(r.InstanceTagList))
+	}
+
+	if state := serverDesiredState(ncloud.StringValue(r.ServerInstanceStatus)); state != "" {
+		d.Set("desired_state", state)
 	}
 
 	instance := ConvertToMap(r)
@@ -295,72 +329,96 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	serverInstance, err := getServerInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	blockStorageList, err := getAdditionalBlockStorageList(config, d.Id())
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if len(blockStorageList) > 0 {
 		for _, blockStorage := range blockStorageList {
 			if err := disconnectBlockStorage(config, blockStorage); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
-			if err := waitForDisconnectBlockStorage(config, d, blockStorage); err != nil {
-				return err
+			if err := waitForDisconnectBlockStorage(ctx, config, d, blockStorage); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
 
-	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
+	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != InstanceStatusStopped {
 		log.Printf("[INFO] Stopping Instance %q for terminate", d.Id())
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
-			return err
+		if err := stopThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	if err := terminateThenWaitServerInstance(config, d.Id()); err != nil {
-		return err
+	if err := terminateThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("server_product_code") {
-		if err := updateServerInstanceSpec(d, config); err != nil {
-			return err
+		if err := updateServerInstanceSpec(ctx, d, config); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("network_interface") {
+		if err := updateServerNetworkInterfaces(ctx, d, config); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("access_control_group_configuration_no_list") {
+		if err := updateServerAccessControlGroups(ctx, d, config); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("desired_state") {
+		if err := updateServerDesiredState(ctx, d, config); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("is_protect_server_termination") {
 		if err := updateServerProtectionTermination(d, config); err != nil {
-			return err
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChanges("tag_list", "tags_all") {
+		if err := updateServerInstanceTags(d, config); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudServerRead(d, meta)
+	return resourceNcloudServerRead(ctx, d, meta)
 }
 
-func createServerInstance(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createServerInstance(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	if config.SupportVPC {
-		return createVpcServerInstance(d, config)
+		return createVpcServerInstance(ctx, d, config)
 	}
 
-	return createClassicServerInstance(d, config)
+	return createClassicServerInstance(ctx, d, config)
 }
 
-func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createClassicServerInstance(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	zoneNo, err := parseZoneNoParameter(config, d)
 	if err != nil {
 		return nil, err
@@ -380,7 +438,8 @@ func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig)
 		RaidTypeName:               StringPtrOrNil(d.GetOk("raid_type_name")),
 	}
 
-	if instanceTagList, err := expandTagListParams(d.Get("tag_list").([]interface{})); err == nil {
+	tagList := expandTagMapToTagList(mergeDefaultTags(config.DefaultTags, d.Get("tag_list").([]interface{})))
+	if instanceTagList, err := expandTagListParams(tagList); err == nil {
 		reqParams.InstanceTagList = instanceTagList
 	}
 
@@ -389,7 +448,7 @@ func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig)
 	}
 
 	var resp *server.CreateServerInstancesResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		var err error
 		logCommonRequest("createClassicServerInstance", reqParams)
 		resp, err = config.Client.server.V2Api.CreateServerInstances(reqParams)
@@ -412,22 +471,18 @@ func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig)
 
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(ctx, config, *serverInstance.ServerInstanceNo, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return nil, err
 	}
 
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func createVpcServerInstance(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createVpcServerInstance(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	if _, ok := d.GetOk("subnet_no"); !ok {
 		return nil, ErrorRequiredArgOnVpc("subnet_no")
 	}
 
-	if _, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
-		return nil, NotSupportVpc("`access_control_group_configuration_no_list` of ncloud_server")
-	}
-
 	if _, ok := d.GetOk("user_data"); ok {
 		return nil, NotSupportVpc("`user_data` of ncloud_server")
 	}
@@ -459,14 +514,19 @@ func createVpcServerInstance(d *schema.ResourceData, config *ProviderConfig) (*s
 	}
 
 	if networkInterfaceList, ok := d.GetOk("network_interface"); !ok {
-		defaultAcgNo, err := getDefaultAccessControlGroup(config, *subnet.VpcNo)
-		if err != nil {
-			return nil, err
+		niParam := &vserver.NetworkInterfaceParameter{
+			NetworkInterfaceOrder: ncloud.Int32(0),
 		}
 
-		niParam := &vserver.NetworkInterfaceParameter{
-			NetworkInterfaceOrder:    ncloud.Int32(0),
-			AccessControlGroupNoList: []*string{ncloud.String(defaultAcgNo)},
+		if acgList, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
+			niParam.AccessControlGroupNoList = expandStringInterfaceList(acgList.([]interface{}))
+		} else {
+			defaultAcgNo, err := getDefaultAccessControlGroup(config, *subnet.VpcNo)
+			if err != nil {
+				return nil, err
+			}
+
+			niParam.AccessControlGroupNoList = []*string{ncloud.String(defaultAcgNo)}
 		}
 
 		reqParams.NetworkInterfaceList = []*vserver.NetworkInterfaceParameter{niParam}
@@ -504,99 +564,287 @@ func createVpcServerInstance(d *schema.ResourceData, config *ProviderConfig) (*s
 	logResponse("createVpcServerInstance", resp)
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(ctx, config, *serverInstance.ServerInstanceNo, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return nil, err
 	}
 
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func waitStateNcloudServerForCreation(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"INIT", "CREAT"},
-		Target:  []string{"RUN"},
+func waitStateNcloudServerForCreation(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	return waitForServerInstanceStatus(ctx, config, id, []string{InstanceStatusInit, InstanceStatusCreated}, []string{InstanceStatusRunning}, timeout)
+}
+
+// waitForServerInstanceStatus waits for the status of the server instance. A terminated server is reported as TERMINATED.
+func waitForServerInstanceStatus(ctx context.Context, config *ProviderConfig, id string, pending, target []string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "ServerInstance",
+		ID:      id,
+		Field:   "state",
+		Pending: pending,
+		Target:  target,
 		Refresh: func() (interface{}, string, error) {
 			instance, err := getServerInstance(config, id)
 			if err != nil {
 				return 0, "", err
 			}
+			if instance == nil { // Instance is terminated.
+				return instance, InstanceStatusTerminated, nil
+			}
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
-		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
+	_, err := waiter.Wait(ctx)
+	return err
+}
+
+// waitForServerInstanceOperation waits for the operation of the server instance.
+func waitForServerInstanceOperation(ctx context.Context, config *ProviderConfig, id string, pending, target []string, timeout time.Duration) error {
+	waiter := &statusWaiter{
+		Name:    "ServerInstance",
+		ID:      id,
+		Field:   "operation",
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			instance, err := getServerInstance(config, id)
+			if err != nil {
+				return 0, "", err
+			}
+			if instance == nil {
+				return nil, "", fmt.Errorf("not found server instance(%s)", id)
+			}
+			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
+		},
+		Timeout: timeout,
 	}
 
-	return nil
+	_, err := waiter.Wait(ctx)
+	return err
 }
 
-func updateServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
+func updateServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	serverInstance, err := getServerInstance(config, d.Id())
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] Stopping Instance %q for server_product_code change", d.Id())
-	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != InstanceStatusStopped {
+		if err := stopThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 	}
 
-	if err := changeServerInstanceSpec(d, config); err != nil {
+	if err := changeServerInstanceSpec(ctx, d, config); err != nil {
 		return err
 	}
 
+	if d.Get("desired_state").(string) == ServerDesiredStateStopped {
+		return nil
+	}
+
 	log.Printf("[INFO] Start Instance %q for server_product_code change", d.Id())
-	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
+	if err := startThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
-	var err error
-	if config.SupportVPC {
-		err = changeVpcServerInstanceSpec(d, config)
-	} else {
-		err = changeClassicServerInstanceSpec(d, config)
+// resourceNcloudServerCustomizeDiff replaces the server for the network changes which can't be applied to the existing server.
+// ACGs of classic server and the primary network interface of VPC server can't be changed after creation.
+// The tags of VPC server are rejected at plan time, as the VPC API can neither create nor change them.
+func resourceNcloudServerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*ProviderConfig)
+	if !ok {
+		return nil
+	}
+
+	if config.SupportVPC && diff.HasChange("tag_list") {
+		if v, ok := diff.GetOk("tag_list"); ok && len(v.([]interface{})) > 0 {
+			return NotSupportVpc("`tag_list` of ncloud_server")
+		}
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !config.SupportVPC {
+		if diff.HasChange("access_control_group_configuration_no_list") {
+			return diff.ForceNew("access_control_group_configuration_no_list")
+		}
+		return nil
+	}
+
+	if diff.HasChange("network_interface") && diff.NewValueKnown("network_interface") {
+		o, n := diff.GetChange("network_interface")
+		if isNetworkInterfaceReplaced(expandNetworkInterfaceOrders(o.([]interface{})), expandNetworkInterfaceOrders(n.([]interface{}))) {
+			return diff.ForceNew("network_interface")
+		}
 	}
 
+	return nil
+}
+
+func updateServerNetworkInterfaces(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	if !config.SupportVPC {
+		return NotSupportClassic("`network_interface`")
+	}
+
+	o, n := d.GetChange("network_interface")
+	detachList, attachList := diffNetworkInterfaces(expandNetworkInterfaceOrders(o.([]interface{})), expandNetworkInterfaceOrders(n.([]interface{})))
+
+	for _, networkInterfaceNo := range detachList {
+		log.Printf("[INFO] Detach network interface %q from Instance %q", networkInterfaceNo, d.Id())
+		if err := detachServerNetworkInterface(ctx, config, networkInterfaceNo, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	for _, networkInterfaceNo := range attachList {
+		log.Printf("[INFO] Attach network interface %q to Instance %q", networkInterfaceNo, d.Id())
+		if err := attachServerNetworkInterface(ctx, config, networkInterfaceNo, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func attachServerNetworkInterface(ctx context.Context, config *ProviderConfig, networkInterfaceNo string, serverInstanceNo string) error {
+	networkInterface, err := getNetworkInterface(config, networkInterfaceNo)
 	if err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"CHNG"},
-		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, d.Id())
+	if networkInterface == nil {
+		return fmt.Errorf("no matching network interface [%s] found", networkInterfaceNo)
+	}
 
-			if err != nil {
-				return 0, "", err
-			}
+	return attachNetworkInterface(ctx, config, networkInterfaceNo, ncloud.StringValue(networkInterface.SubnetNo), serverInstanceNo)
+}
 
-			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
-		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+func detachServerNetworkInterface(ctx context.Context, config *ProviderConfig, networkInterfaceNo string, serverInstanceNo string) error {
+	networkInterface, err := getNetworkInterface(config, networkInterfaceNo)
+	if err != nil {
+		return err
+	}
+
+	if networkInterface == nil {
+		return nil
+	}
+
+	return detachNetworkInterface(ctx, config, networkInterfaceNo, ncloud.StringValue(networkInterface.SubnetNo), serverInstanceNo)
+}
+
+func updateServerAccessControlGroups(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	if !config.SupportVPC {
+		return NotSupportClassic("changing `access_control_group_configuration_no_list`")
+	}
+
+	networkInterfaceNo := primaryNetworkInterfaceNo(d.Get("network_interface").([]interface{}))
+	if networkInterfaceNo == "" {
+		return fmt.Errorf("no primary network interface found in server instance(%s)", d.Id())
+	}
+
+	o, n := d.GetChange("access_control_group_configuration_no_list")
+	os := schema.NewSet(schema.HashString, o.([]interface{}))
+	ns := schema.NewSet(schema.HashString, n.([]interface{}))
+
+	addAcgList := expandStringInterfaceList(ns.Difference(os).List())
+	removeAcgList := expandStringInterfaceList(os.Difference(ns).List())
+
+	// First do add ACG prevent error '[1002035] At least one Acg must remain on the network interface.'
+	if len(addAcgList) > 0 {
+		if err := addNetworkInterfaceAccessControlGroup(ctx, config, networkInterfaceNo, addAcgList); err != nil {
+			return err
+		}
+	}
+
+	if len(removeAcgList) > 0 {
+		if err := removeNetworkInterfaceAccessControlGroup(ctx, config, networkInterfaceNo, removeAcgList, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setServerAccessControlGroups sets the ACGs of the primary network interface, keeping the order of the configuration.
+func setServerAccessControlGroups(d *schema.ResourceData, r *ServerInstance) {
+	for _, ni := range r.NetworkInterfaceList {
+		if ncloud.Int32Value(ni.Order) != 0 || len(ni.AccessControlGroupNoList) == 0 {
+			continue
+		}
+
+		current := schema.NewSet(schema.HashString, d.Get("access_control_group_configuration_no_list").([]interface{}))
+		actual := schema.NewSet(schema.HashString, flattenStringList(ni.AccessControlGroupNoList))
+		if !current.Equal(actual) {
+			d.Set("access_control_group_configuration_no_list", ncloud.StringListValue(ni.AccessControlGroupNoList))
+		}
 	}
+}
 
-	_, err = stateConf.WaitForState()
+func updateServerDesiredState(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	serverInstance, err := getServerInstance(config, d.Id())
 	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("not found server instance(%s)", d.Id())
+	}
+
+	status := ncloud.StringValue(serverInstance.ServerInstanceStatus)
+
+	switch d.Get("desired_state").(string) {
+	case ServerDesiredStateRunning:
+		if status != InstanceStatusRunning {
+			log.Printf("[INFO] Start Instance %q for desired_state", d.Id())
+			return startThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		}
+	case ServerDesiredStateStopped:
+		if status != InstanceStatusStopped {
+			log.Printf("[INFO] Stopping Instance %q for desired_state", d.Id())
+			return stopThenWaitServerInstance(ctx, config, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		}
 	}
 
 	return nil
 }
 
+// serverDesiredState returns the desired_state of the server instance status.
+// It returns empty while the server is changing its status. (e.g. booting, shutting down)
+func serverDesiredState(status string) string {
+	switch status {
+	case InstanceStatusRunning:
+		return ServerDesiredStateRunning
+	case InstanceStatusStopped:
+		return ServerDesiredStateStopped
+	}
+
+	return ""
+}
+
+func changeServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	var err error
+	if config.SupportVPC {
+		err = changeVpcServerInstanceSpec(d, config)
+	} else {
+		err = changeClassicServerInstanceSpec(d, config)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return waitForServerInstanceOperation(ctx, config, d.Id(), []string{InstanceOperationChange}, []string{InstanceOperationNull}, d.Timeout(schema.TimeoutUpdate))
+}
+
 func changeClassicServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
 	reqParams := &server.ChangeServerInstanceSpecRequest{
 		ServerInstanceNo:  ncloud.String(d.Get("instance_no").(string)),
@@ -675,42 +923,89 @@ func updateClassicServerProtectionTermination(d *schema.ResourceData, config *Pr
 	return nil
 }
 
-func startThenWaitServerInstance(config *ProviderConfig, id string) error {
-	var err error
+func updateServerInstanceTags(d *schema.ResourceData, config *ProviderConfig) error {
+	// The tags of VPC server are rejected by resourceNcloudServerCustomizeDiff
 	if config.SupportVPC {
-		err = startVpcServerInstance(config, id)
-	} else {
-		err = startClassicServerInstance(config, id)
+		return nil
+	}
+
+	o, n := d.GetChange("tags_all")
+	removeTags, addTags := diffInstanceTags(expandStringMap(o.(map[string]interface{})), expandStringMap(n.(map[string]interface{})))
+
+	if len(removeTags) > 0 {
+		if err := deleteClassicInstanceTags(config, d.Id(), removeTags); err != nil {
+			return err
+		}
 	}
 
+	if len(addTags) > 0 {
+		if err := createClassicInstanceTags(config, d.Id(), addTags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createClassicInstanceTags(config *ProviderConfig, id string, tags map[string]string) error {
+	instanceTagList, err := expandTagListParams(expandTagMapToTagList(tags))
 	if err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"NSTOP"},
-		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
+	reqParams := &server.CreateInstanceTagsRequest{
+		InstanceNoList:  []*string{ncloud.String(id)},
+		InstanceTagList: instanceTagList,
+	}
 
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
-		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+	logCommonRequest("createClassicInstanceTags", reqParams)
+	resp, err := config.Client.server.V2Api.CreateInstanceTags(reqParams)
+	if err != nil {
+		logErrorResponse("createClassicInstanceTags", err, reqParams)
+		return err
 	}
+	logResponse("createClassicInstanceTags", resp)
+
+	return nil
+}
 
-	_, err = stateConf.WaitForState()
+func deleteClassicInstanceTags(config *ProviderConfig, id string, tags map[string]string) error {
+	instanceTagList, err := expandTagListParams(expandTagMapToTagList(tags))
 	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
+		return err
+	}
+
+	reqParams := &server.DeleteInstanceTagsRequest{
+		InstanceNoList:  []*string{ncloud.String(id)},
+		InstanceTagList: instanceTagList,
 	}
 
+	logCommonRequest("deleteClassicInstanceTags", reqParams)
+	resp, err := config.Client.server.V2Api.DeleteInstanceTags(reqParams)
+	if err != nil {
+		logErrorResponse("deleteClassicInstanceTags", err, reqParams)
+		return err
+	}
+	logResponse("deleteClassicInstanceTags", resp)
+
 	return nil
 }
 
+func startThenWaitServerInstance(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	var err error
+	if config.SupportVPC {
+		err = startVpcServerInstance(config, id)
+	} else {
+		err = startClassicServerInstance(config, id)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return waitForServerInstanceStatus(ctx, config, id, []string{InstanceStatusStopped}, []string{InstanceStatusRunning}, timeout)
+}
+
 func startClassicServerInstance(config *ProviderConfig, id string) error {
 	reqParams := &server.StartServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
@@ -755,25 +1050,32 @@ func getClassicServerInstance(config *ProviderConfig, id string) (*ServerInstanc
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
 
-	logCommonRequest("getClassicServerInstance", reqParams)
-	resp, err := config.Client.server.V2Api.GetServerInstanceList(reqParams)
+	var serverInstanceList []*server.ServerInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getClassicServerInstance", reqParams)
+		resp, err := config.Client.server.V2Api.GetServerInstanceList(reqParams)
+		if err != nil {
+			logErrorResponse("getClassicServerInstance", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getClassicServerInstance", resp)
 
+		serverInstanceList = append(serverInstanceList, resp.ServerInstanceList...)
+		return len(resp.ServerInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getClassicServerInstance", err, reqParams)
 		return nil, err
 	}
 
-	logResponse("getClassicServerInstance", resp)
-
-	if len(resp.ServerInstanceList) == 0 {
+	if len(serverInstanceList) == 0 {
 		return nil, nil
 	}
 
-	if err := validateOneResult(len(resp.ServerInstanceList)); err != nil {
+	if err := validateOneResult(len(serverInstanceList)); err != nil {
 		return nil, err
 	}
 
-	return convertClassicServerInstance(resp.ServerInstanceList[0]), nil
+	return convertClassicServerInstance(serverInstanceList[0]), nil
 }
 
 func convertClassicServerInstance(r *server.ServerInstance) *ServerInstance {
@@ -899,33 +1201,16 @@ func buildNetworkInterfaceList(config *ProviderConfig, r *ServerInstance) error
 		ni.SubnetNo = networkInterface.SubnetNo
 		ni.NetworkInterfaceNo = networkInterface.NetworkInterfaceNo
 		ni.Order = ncloud.Int32(int32(order))
+		ni.AccessControlGroupNoList = networkInterface.AccessControlGroupNoList
 	}
 
 	return nil
 }
 
-func stopThenWaitServerInstance(config *ProviderConfig, id string) error {
-	var err error
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"SETUP"},
-		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-
-			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
-		},
-		Timeout:    DefaultStopTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
+func stopThenWaitServerInstance(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
+	err := waitForServerInstanceOperation(ctx, config, id, []string{InstanceOperationSetup}, []string{InstanceOperationNull}, timeout)
 	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
+		return err
 	}
 
 	if config.SupportVPC {
@@ -938,28 +1223,7 @@ func stopThenWaitServerInstance(config *ProviderConfig, id string) error {
 		return err
 	}
 
-	stateConf = &resource.StateChangeConf{
-		Pending: []string{"RUN"},
-		Target:  []string{"NSTOP"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
-		},
-		Timeout:    DefaultStopTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance state to be \"NSTOP\": %s", err)
-	}
-
-	return nil
+	return waitForServerInstanceStatus(ctx, config, id, []string{InstanceStatusRunning}, []string{InstanceStatusStopped}, timeout)
 }
 
 func stopClassicServerInstance(config *ProviderConfig, id string) error {
@@ -993,52 +1257,28 @@ func stopVpcServerInstance(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func terminateThenWaitServerInstance(config *ProviderConfig, id string) error {
+func terminateThenWaitServerInstance(ctx context.Context, config *ProviderConfig, id string, timeout time.Duration) error {
 	var err error
 	if config.SupportVPC {
 		err = terminateVpcServerInstance(config, id)
 	} else {
-		err = terminateClassicServerInstance(config, id)
+		err = terminateClassicServerInstance(ctx, config, id)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"NSTOP"},
-		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-
-			if err != nil {
-				return 0, "", err
-			}
-			if instance == nil { // Instance is terminated.
-				return instance, "TERMINATED", nil
-			}
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
-		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance state to be \"TERMINATED\": %s", err)
-	}
-
-	return nil
+	return waitForServerInstanceStatus(ctx, config, id, []string{InstanceStatusStopped}, []string{InstanceStatusTerminated}, timeout)
 }
 
-func terminateClassicServerInstance(config *ProviderConfig, id string) error {
+func terminateClassicServerInstance(ctx context.Context, config *ProviderConfig, id string) error {
 	reqParams := &server.TerminateServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
 
 	var resp *server.TerminateServerInstancesResponse
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		var err error
 		logCommonRequest("terminateClassicServerInstance", reqParams)
 		resp, err = config.Client.server.V2Api.TerminateServerInstances(reqParams)
@@ -1089,22 +1329,32 @@ func getAdditionalBlockStorageList(config *ProviderConfig, id string) ([]*BlockS
 }
 
 func getVpcAdditionalBlockStorageList(config *ProviderConfig, id string) ([]*BlockStorage, error) {
-	resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{
+	reqParams := &vserver.GetBlockStorageInstanceListRequest{
 		RegionCode:               &config.RegionCode,
 		ServerInstanceNo:         ncloud.String(id),
 		BlockStorageTypeCodeList: []*string{ncloud.String("SVRBS")},
-	})
+	}
 
+	var blockStorageInstanceList []*vserver.BlockStorageInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		blockStorageInstanceList = append(blockStorageInstanceList, resp.BlockStorageInstanceList...)
+		return len(resp.BlockStorageInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.BlockStorageInstanceList) < 1 {
+	if len(blockStorageInstanceList) < 1 {
 		return nil, nil
 	}
 
 	blockStorageList := make([]*BlockStorage, 0)
-	for _, blockStorage := range resp.BlockStorageInstanceList {
+	for _, blockStorage := range blockStorageInstanceList {
 		blockStorageList = append(blockStorageList, convertVpcBlockStorage(blockStorage))
 	}
 
@@ -1112,22 +1362,32 @@ func getVpcAdditionalBlockStorageList(config *ProviderConfig, id string) ([]*Blo
 }
 
 func getClassicAdditionalBlockStorageList(config *ProviderConfig, id string) ([]*BlockStorage, error) {
-	resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(&server.GetBlockStorageInstanceListRequest{
+	reqParams := &server.GetBlockStorageInstanceListRequest{
 		RegionNo:                 &config.RegionCode,
 		ServerInstanceNo:         ncloud.String(id),
 		BlockStorageTypeCodeList: []*string{ncloud.String("SVRBS")},
-	})
+	}
 
+	var blockStorageInstanceList []*server.BlockStorageInstance
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(reqParams)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		blockStorageInstanceList = append(blockStorageInstanceList, resp.BlockStorageInstanceList...)
+		return len(resp.BlockStorageInstanceList), resp.TotalRows, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.BlockStorageInstanceList) < 1 {
+	if len(blockStorageInstanceList) < 1 {
 		return nil, nil
 	}
 
 	blockStorageList := make([]*BlockStorage, 0)
-	for _, blockStorage := range resp.BlockStorageInstanceList {
+	for _, blockStorage := range blockStorageInstanceList {
 		blockStorageList = append(blockStorageList, convertClassicBlockStorage(blockStorage))
 	}
 
@@ -1204,8 +1464,8 @@ func disconnectClassicBlockStorage(config *ProviderConfig, storage *BlockStorage
 	return nil
 }
 
-func waitForDisconnectBlockStorage(config *ProviderConfig, d *schema.ResourceData, storage *BlockStorage) error {
-	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+func waitForDisconnectBlockStorage(ctx context.Context, config *ProviderConfig, d *schema.ResourceData, storage *BlockStorage) error {
+	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		blockStorage, err := getBlockStorage(config, *storage.BlockStorageInstanceNo)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -1271,4 +1531,6 @@ type ServerInstanceNetworkInterface struct {
 	NetworkInterfaceNo *string `json:"network_interface_no,omitempty"`
 	PrivateIp          *string `json:"private_ip,omitempty"`
 	SubnetNo           *string `json:"subnet_no,omitempty"`
+	// ACGs are set to `access_control_group_configuration_no_list` for the primary network interface
+	AccessControlGroupNoList []*string `json:"-"`
 }
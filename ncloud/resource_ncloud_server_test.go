@@ -1,8 +1,10 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -241,6 +243,64 @@ func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServer_vpc_changeAccessControlGroup(t *testing.T) {
+	var before ServerInstance
+	var after ServerInstance
+	testServerName := getTestServerName()
+	resourceName := "ncloud_server.server"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigAccessControlGroup(testServerName, "[ncloud_vpc.test.default_access_control_group_no]"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, testAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "access_control_group_configuration_no_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigAccessControlGroup(testServerName, "[ncloud_vpc.test.default_access_control_group_no, ncloud_access_control_group.test.id]"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, testAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "access_control_group_configuration_no_list.#", "2"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_vpc_attachNetworkInterface(t *testing.T) {
+	var before ServerInstance
+	var after ServerInstance
+	testServerName := getTestServerName()
+	resourceName := "ncloud_server.server"
+	productCode := "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigNetworkInterfaceWithOptional(testServerName, productCode, false),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, testAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "1"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigNetworkInterfaceWithOptional(testServerName, productCode, true),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, testAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.1.order", "1"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServerExistsWithProvider(n string, i *ServerInstance, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -267,6 +327,70 @@ func testAccCheckServerExistsWithProvider(n string, i *ServerInstance, provider
 	}
 }
 
+func TestAccResourceNcloudServer_classic_changeTags(t *testing.T) {
+	var before ServerInstance
+	var after ServerInstance
+	testServerName := getTestServerName()
+	resourceName := "ncloud_server.server"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccClassicProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckInstanceDestroyWithProvider(state, testAccClassicProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerClassicConfigTags(testServerName, "dev"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "dev"),
+				),
+			},
+			{
+				Config: testAccServerClassicConfigTags(testServerName, "prod"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "prod"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_classic_desiredState(t *testing.T) {
+	var before ServerInstance
+	var after ServerInstance
+	testServerName := getTestServerName()
+	resourceName := "ncloud_server.server"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccClassicProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckInstanceDestroyWithProvider(state, testAccClassicProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerClassicConfigDesiredState(testServerName, "STOPPED"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "NSTOP"),
+				),
+			},
+			{
+				Config: testAccServerClassicConfigDesiredState(testServerName, "RUNNING"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUN"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceNotRecreated(t *testing.T, before, after *ServerInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *before.ServerInstanceNo != *after.ServerInstanceNo {
@@ -414,3 +538,325 @@ resource "ncloud_server" "server" {
 }
 `, testServerName, productCode)
 }
+
+func testAccServerClassicConfigTags(testServerName, env string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_server" "server" {
+	name = "%[1]s"
+	server_image_product_code = "SPSW0LINUX000045"
+	server_product_code = "SPSVRSTAND000004"
+	login_key_name = "${ncloud_login_key.loginkey.key_name}"
+
+	tag_list {
+		tag_key = "env"
+		tag_value = "%[2]s"
+	}
+
+	tag_list {
+		tag_key = "owner"
+		tag_value = "terraform"
+	}
+}
+`, testServerName, env)
+}
+
+func testAccServerClassicConfigDesiredState(testServerName, desiredState string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_server" "server" {
+	name = "%[1]s"
+	server_image_product_code = "SPSW0LINUX000045"
+	server_product_code = "SPSVRSTAND000004"
+	login_key_name = "${ncloud_login_key.loginkey.key_name}"
+	desired_state = "%[2]s"
+}
+`, testServerName, desiredState)
+}
+
+func testAccServerVpcConfigAccessControlGroup(testServerName, acgList string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_access_control_group" "test" {
+	name               = "%[1]s"
+	vpc_no             = ncloud_vpc.test.id
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.CNTOS.0703.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	access_control_group_configuration_no_list = %[2]s
+}
+`, testServerName, acgList)
+}
+
+func testAccServerVpcConfigNetworkInterfaceWithOptional(testServerName, productCode string, withSecondary bool) string {
+	secondary := ""
+	if withSecondary {
+		secondary = `
+	network_interface {
+		order = 1
+		network_interface_no = ncloud_network_interface.eth1.id
+	}`
+	}
+
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "public_subnet" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s-pub"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_subnet" "private_subnet" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s-priv"
+	subnet             = "10.5.1.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_network_interface" "eth0" {
+	name                  = "%[1]s-eth-0"
+	subnet_no             = ncloud_subnet.public_subnet.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+}
+
+resource "ncloud_network_interface" "eth1" {
+	name                  = "%[1]s-eth-1"
+	subnet_no             = ncloud_subnet.private_subnet.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.public_subnet.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.CNTOS.0703.B050"
+	server_product_code = "%[2]s"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	network_interface {
+		order = 0
+		network_interface_no = ncloud_network_interface.eth0.id
+	}
+%[3]s
+}
+`, testServerName, productCode, secondary)
+}
+
+func TestResourceNcloudServer_vpc_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_server"]
+
+	vpcNo, subnetNo := e.seedVpc("10.5.0.0/16", "10.5.0.0/24")
+	defaultAcgNo, err := getDefaultAccessControlGroup(config, vpcNo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loginKey := testEmulatorApply(t, p.ResourcesMap["ncloud_login_key"], nil, map[string]interface{}{
+		"key_name": "tf-emulator-key",
+	}, config)
+	acg := testEmulatorApply(t, p.ResourcesMap["ncloud_access_control_group"], nil, map[string]interface{}{
+		"name":   "tf-emulator-acg",
+		"vpc_no": vpcNo,
+	}, config)
+
+	raw := map[string]interface{}{
+		"subnet_no":                 subnetNo,
+		"name":                      "tf-emulator-server",
+		"server_image_product_code": "SW.VSVR.OS.LNX64.CNTOS.0703.B050",
+		"server_product_code":       "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002",
+		"login_key_name":            loginKey.ID,
+		"access_control_group_configuration_no_list": []interface{}{defaultAcgNo},
+	}
+	state := testEmulatorApply(t, r, nil, raw, config)
+
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":                      "tf-emulator-server",
+		"instance_no":               state.ID,
+		"vpc_no":                    vpcNo,
+		"zone":                      "KR-1",
+		"desired_state":             ServerDesiredStateRunning,
+		"network_interface.#":       "1",
+		"network_interface.0.order": "0",
+		"access_control_group_configuration_no_list.#": "1",
+	})
+
+	// The ACGs are changed in place
+	raw["access_control_group_configuration_no_list"] = []interface{}{defaultAcgNo, acg.ID}
+	updated := testEmulatorApply(t, r, state, raw, config)
+	testCheckEmulatorState(t, updated, map[string]string{
+		"access_control_group_configuration_no_list.#": "2",
+	})
+	if updated.ID != state.ID {
+		t.Fatalf("Expected server to be updated in place, got %s from %s", updated.ID, state.ID)
+	}
+
+	// The tags of VPC server are rejected at plan time
+	tagged := map[string]interface{}{
+		"tag_list": []interface{}{
+			map[string]interface{}{"tag_key": "env", "tag_value": "dev"},
+		},
+	}
+	for k, v := range raw {
+		tagged[k] = v
+	}
+	if _, err := r.Diff(context.Background(), updated, terraform.NewResourceConfigRaw(tagged), config); err == nil || !strings.Contains(err.Error(), "tag_list") {
+		t.Fatalf("Expected tag_list to be rejected on VPC, got %v", err)
+	}
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tagged), config); err == nil {
+		t.Fatal("Expected tag_list to be rejected on VPC server creation")
+	}
+
+	raw["desired_state"] = ServerDesiredStateStopped
+	stopped := testEmulatorApply(t, r, updated, raw, config)
+	testCheckEmulatorState(t, stopped, map[string]string{
+		"instance_no":   state.ID,
+		"desired_state": ServerDesiredStateStopped,
+	})
+
+	imported := testEmulatorImport(t, r, state.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"name":           "tf-emulator-server",
+		"login_key_name": loginKey.ID,
+		"desired_state":  ServerDesiredStateStopped,
+	})
+
+	testEmulatorDestroy(t, r, stopped, config)
+
+	if instance, err := getVpcServerInstance(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected server to be terminated, got %v, %v", instance, err)
+	}
+}
+
+func TestResourceNcloudServer_vpc_emulatorRetry(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_server"]
+
+	_, subnetNo := e.seedVpc("10.6.0.0/16", "10.6.0.0/24")
+
+	// The API rejects the request while the object is in operation, which is retried by the transport
+	e.failNext("vserver", "createServerInstances", 2, 400, ApiErrorObjectInOperation)
+
+	state := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"subnet_no":                 subnetNo,
+		"server_image_product_code": "SW.VSVR.OS.LNX64.CNTOS.0703.B050",
+		"server_product_code":       "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002",
+	}, config)
+
+	if state.ID == "" {
+		t.Fatal("Expected server to be created")
+	}
+	if count := e.callCount("vserver", "createServerInstances"); count != 3 {
+		t.Errorf("Expected createServerInstances to be called 3 times, got %d", count)
+	}
+}
+
+func TestResourceNcloudServer_classic_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, false)
+	r := p.ResourcesMap["ncloud_server"]
+
+	loginKey := testEmulatorApply(t, p.ResourcesMap["ncloud_login_key"], nil, map[string]interface{}{
+		"key_name": "tf-emulator-key",
+	}, config)
+
+	raw := map[string]interface{}{
+		"name":                      "tf-emulator-server",
+		"server_image_product_code": "SPSW0LINUX000045",
+		"server_product_code":       "SPSVRSTAND000004",
+		"login_key_name":            loginKey.ID,
+		"zone":                      "KR-2",
+		"tag_list": []interface{}{
+			map[string]interface{}{"tag_key": "env", "tag_value": "dev"},
+			map[string]interface{}{"tag_key": "owner", "tag_value": "terraform"},
+		},
+	}
+	state := testEmulatorApply(t, r, nil, raw, config)
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":          "tf-emulator-server",
+		"zone":          "KR-2",
+		"desired_state": ServerDesiredStateRunning,
+		"tag_list.#":    "2",
+	})
+
+	// The tags are changed in place
+	raw["tag_list"].([]interface{})[0].(map[string]interface{})["tag_value"] = "prod"
+	updated := testEmulatorApply(t, r, state, raw, config)
+	if updated.ID != state.ID {
+		t.Fatalf("Expected server to be updated in place, got %s from %s", updated.ID, state.ID)
+	}
+
+	instance, err := getClassicServerInstance(config, state.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := map[string]string{}
+	for _, tag := range instance.InstanceTagList {
+		tags[*tag.TagKey] = *tag.TagValue
+	}
+	if len(tags) != 2 || tags["env"] != "prod" || tags["owner"] != "terraform" {
+		t.Errorf("Expected tags env=prod and owner=terraform, got %v", tags)
+	}
+
+	raw["desired_state"] = ServerDesiredStateStopped
+	stopped := testEmulatorApply(t, r, updated, raw, config)
+	testCheckEmulatorState(t, stopped, map[string]string{
+		"desired_state": ServerDesiredStateStopped,
+	})
+
+	testEmulatorDestroy(t, r, stopped, config)
+
+	if instance, err := getClassicServerInstance(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected server to be terminated, got %v, %v", instance, err)
+	}
+}
@@ -1,12 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,12 +24,12 @@ const (
 
 func resourceNcloudSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudSubnetCreate,
-		Read:   resourceNcloudSubnetRead,
-		Update: resourceNcloudSubnetUpdate,
-		Delete: resourceNcloudSubnetDelete,
+		CreateContext: resourceNcloudSubnetCreate,
+		ReadContext:   resourceNcloudSubnetRead,
+		UpdateContext: resourceNcloudSubnetUpdate,
+		DeleteContext: resourceNcloudSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -80,11 +82,11 @@ func resourceNcloudSubnet() *schema.Resource {
 	}
 }
 
-func resourceNcloudSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_subnet`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_subnet`"))
 	}
 
 	reqParams := &vpc.CreateSubnetRequest{
@@ -106,7 +108,7 @@ func resourceNcloudSubnetCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var resp *vpc.CreateSubnetResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 		logCommonRequest("CreateSubnet", reqParams)
 		resp, err = config.Client.vpc.V2Api.CreateSubnet(reqParams)
@@ -115,7 +117,7 @@ func resourceNcloudSubnetCreate(d *schema.ResourceData, meta interface{}) error
 			errBody, _ := GetCommonErrorBody(err)
 			if errBody.ReturnCode == "1001015" || errBody.ReturnCode == SubnetPleaseTryAgainErrorCode {
 				logErrorResponse("retry CreateSubnet", err, reqParams)
-				time.Sleep(time.Second * 5)
+				time.Sleep(retryDelay)
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -124,26 +126,26 @@ func resourceNcloudSubnetCreate(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instance := resp.SubnetList[0]
 	d.SetId(*instance.SubnetNo)
 	log.Printf("[INFO] Subnet ID: %s", d.Id())
 
-	if err := waitForNcloudSubnetCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudSubnetCreation(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudSubnetRead(d, meta)
+	return resourceNcloudSubnetRead(ctx, d, meta)
 }
 
-func resourceNcloudSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getSubnetInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -164,7 +166,7 @@ func resourceNcloudSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceNcloudSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("network_acl_no") {
@@ -178,19 +180,19 @@ func resourceNcloudSubnetUpdate(d *schema.ResourceData, meta interface{}) error
 		resp, err := config.Client.vpc.V2Api.SetSubnetNetworkAcl(reqParams)
 		if err != nil {
 			logErrorResponse("SetSubnetNetworkAcl", err, reqParams)
-			return err
+			return diagFromErr(err)
 		}
 		logResponse("SetSubnetNetworkAcl", resp)
 
-		if err := waitForNcloudNetworkACLUpdate(config, d.Get("network_acl_no").(string)); err != nil {
-			return err
+		if err := waitForNcloudNetworkACLUpdate(ctx, config, d.Get("network_acl_no").(string)); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudSubnetRead(d, meta)
+	return resourceNcloudSubnetRead(ctx, d, meta)
 }
 
-func resourceNcloudSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteSubnetRequest{
@@ -202,18 +204,18 @@ func resourceNcloudSubnetDelete(d *schema.ResourceData, meta interface{}) error
 	resp, err := config.Client.vpc.V2Api.DeleteSubnet(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteSubnet", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 	logResponse("DeleteSubnet", resp)
 
-	if err := waitForNcloudSubnetDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudSubnetDeletion(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudSubnetCreation(config *ProviderConfig, id string) error {
+func waitForNcloudSubnetCreation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
@@ -222,18 +224,18 @@ func waitForNcloudSubnetCreation(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "SubnetStatus")
 		},
 		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Subnet (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLUpdate(config *ProviderConfig, id string) error {
+func waitForNcloudNetworkACLUpdate(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
@@ -242,18 +244,18 @@ func waitForNcloudNetworkACLUpdate(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Set network ACL for Subnet (%s) to become running: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudSubnetDeletion(config *ProviderConfig, id string) error {
+func waitForNcloudSubnetDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
@@ -262,11 +264,11 @@ func waitForNcloudSubnetDeletion(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "SubnetStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Subnet (%s) to become termintaing: %s", id, err)
 	}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -256,10 +257,54 @@ func testAccCheckSubnetDisappears(instance *vpc.Subnet) resource.TestCheckFunc {
 
 		_, err := config.Client.vpc.V2Api.DeleteSubnet(reqParams)
 
-		if err := waitForNcloudSubnetDeletion(config, *instance.SubnetNo); err != nil {
+		if err := waitForNcloudSubnetDeletion(context.Background(), config, *instance.SubnetNo); err != nil {
 			return err
 		}
 
 		return err
 	}
 }
+
+func TestResourceNcloudSubnet_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_subnet"]
+
+	vpcNo, _ := e.seedVpc("10.1.0.0/16", "10.1.0.0/24")
+	networkAclNo, err := getDefaultNetworkACL(config, vpcNo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"name":           "tf-emulator-subnet",
+		"vpc_no":         vpcNo,
+		"subnet":         "10.1.1.0/24",
+		"zone":           "KR-2",
+		"network_acl_no": networkAclNo,
+		"subnet_type":    "PRIVATE",
+	}, config)
+
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":           "tf-emulator-subnet",
+		"vpc_no":         vpcNo,
+		"subnet":         "10.1.1.0/24",
+		"zone":           "KR-2",
+		"subnet_type":    "PRIVATE",
+		"usage_type":     "GEN",
+		"network_acl_no": networkAclNo,
+	})
+
+	imported := testEmulatorImport(t, r, state.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"subnet": "10.1.1.0/24",
+	})
+
+	testEmulatorDestroy(t, r, state, config)
+
+	if instance, err := getSubnetInstance(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected subnet to be deleted, got %v, %v", instance, err)
+	}
+}
@@ -1,13 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"log"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,11 +20,11 @@ func init() {
 
 func resourceNcloudVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudVpcCreate,
-		Read:   resourceNcloudVpcRead,
-		Delete: resourceNcloudVpcDelete,
+		CreateContext: resourceNcloudVpcCreate,
+		ReadContext:   resourceNcloudVpcRead,
+		DeleteContext: resourceNcloudVpcDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -65,11 +66,11 @@ func resourceNcloudVpc() *schema.Resource {
 	}
 }
 
-func resourceNcloudVpcCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_vpc`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_vpc`"))
 	}
 
 	reqParams := &vpc.CreateVpcRequest{
@@ -85,7 +86,7 @@ func resourceNcloudVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	resp, err := config.Client.vpc.V2Api.CreateVpc(reqParams)
 	if err != nil {
 		logErrorResponse("Create Vpc Instance", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logCommonResponse("CreateVpc", GetCommonResponse(resp))
@@ -94,19 +95,19 @@ func resourceNcloudVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*vpcInstance.VpcNo)
 	log.Printf("[INFO] VPC ID: %s", d.Id())
 
-	if err := waitForNcloudVpcCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudVpcCreation(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudVpcRead(d, meta)
+	return resourceNcloudVpcRead(ctx, d, meta)
 }
 
-func resourceNcloudVpcRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getVpcInstance(config, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -122,20 +123,20 @@ func resourceNcloudVpcRead(d *schema.ResourceData, meta interface{}) error {
 	if *instance.VpcStatus.Code != "TERMTING" {
 		defaultNetworkACLNo, err := getDefaultNetworkACL(config, d.Id())
 		if err != nil {
-			return fmt.Errorf("error get default network acl for VPC (%s): %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf("error get default network acl for VPC (%s): %s", d.Id(), err))
 		}
 
 		d.Set("default_network_acl_no", defaultNetworkACLNo)
 
 		defaultAcgNo, err := getDefaultAccessControlGroup(config, d.Id())
 		if err != nil {
-			return fmt.Errorf("error get default Access Control Group for VPC (%s): %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf("error get default Access Control Group for VPC (%s): %s", d.Id(), err))
 		}
 		d.Set("default_access_control_group_no", defaultAcgNo)
 
 		publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(config, d.Id())
 		if err != nil {
-			return fmt.Errorf("error get default Route Table for VPC (%s): %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf("error get default Route Table for VPC (%s): %s", d.Id(), err))
 		}
 		d.Set("default_public_route_table_no", publicRouteTableNo)
 		d.Set("default_private_route_table_no", privateRouteTableNo)
@@ -150,21 +151,28 @@ func getDefaultNetworkACL(config *ProviderConfig, id string) (string, error) {
 		VpcNo:      ncloud.String(id),
 	}
 
-	logCommonRequest("GetNetworkAclList", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetNetworkAclList(reqParams)
+	var networkAclList []*vpc.NetworkAcl
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("GetNetworkAclList", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetNetworkAclList(reqParams)
+		if err != nil {
+			logErrorResponse("GetNetworkAclList", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("GetNetworkAclList", resp)
 
+		networkAclList = append(networkAclList, resp.NetworkAclList...)
+		return len(resp.NetworkAclList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("GetNetworkAclList", err, reqParams)
 		return "", err
 	}
 
-	logResponse("GetNetworkAclList", resp)
-
-	if resp == nil || len(resp.NetworkAclList) == 0 {
+	if len(networkAclList) == 0 {
 		return "", fmt.Errorf("no matching Network ACL found")
 	}
 
-	for _, i := range resp.NetworkAclList {
+	for _, i := range networkAclList {
 		if *i.IsDefault {
 			return *i.NetworkAclNo, nil
 		}
@@ -179,21 +187,28 @@ func getDefaultAccessControlGroup(config *ProviderConfig, id string) (string, er
 		VpcNo:      ncloud.String(id),
 	}
 
-	logCommonRequest("getDefaultAccessControlGroup", reqParams)
-	resp, err := config.Client.vserver.V2Api.GetAccessControlGroupList(reqParams)
+	var accessControlGroupList []*vserver.AccessControlGroup
+	err := paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getDefaultAccessControlGroup", reqParams)
+		resp, err := config.Client.vserver.V2Api.GetAccessControlGroupList(reqParams)
+		if err != nil {
+			logErrorResponse("getDefaultAccessControlGroup", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getDefaultAccessControlGroup", resp)
 
+		accessControlGroupList = append(accessControlGroupList, resp.AccessControlGroupList...)
+		return len(resp.AccessControlGroupList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getDefaultAccessControlGroup", err, reqParams)
 		return "", err
 	}
 
-	logResponse("getDefaultAccessControlGroup", resp)
-
-	if resp == nil || len(resp.AccessControlGroupList) == 0 {
+	if len(accessControlGroupList) == 0 {
 		return "", fmt.Errorf("no matching Access Control Group found")
 	}
 
-	for _, i := range resp.AccessControlGroupList {
+	for _, i := range accessControlGroupList {
 		if *i.IsDefault {
 			return *i.AccessControlGroupNo, nil
 		}
@@ -202,23 +217,30 @@ func getDefaultAccessControlGroup(config *ProviderConfig, id string) (string, er
 	return "", fmt.Errorf("No matching default Access Control Group found")
 }
 
-func getDefaultRouteTable(config *ProviderConfig, id string) (publicRouteTableNo string, privateRouteTableNo string, error error) {
+func getDefaultRouteTable(config *ProviderConfig, id string) (publicRouteTableNo string, privateRouteTableNo string, err error) {
 	reqParams := &vpc.GetRouteTableListRequest{
 		RegionCode: &config.RegionCode,
 		VpcNo:      ncloud.String(id),
 	}
 
-	logCommonRequest("getDefaultRouteTable", reqParams)
-	resp, err := config.Client.vpc.V2Api.GetRouteTableList(reqParams)
+	var routeTableList []*vpc.RouteTable
+	err = paginate(&reqParams.PageNo, &reqParams.PageSize, func() (int, *int32, error) {
+		logCommonRequest("getDefaultRouteTable", reqParams)
+		resp, err := config.Client.vpc.V2Api.GetRouteTableList(reqParams)
+		if err != nil {
+			logErrorResponse("getDefaultRouteTable", err, reqParams)
+			return 0, nil, err
+		}
+		logResponse("getDefaultRouteTable", resp)
 
+		routeTableList = append(routeTableList, resp.RouteTableList...)
+		return len(resp.RouteTableList), resp.TotalRows, nil
+	})
 	if err != nil {
-		logErrorResponse("getDefaultRouteTable", err, reqParams)
 		return "", "", err
 	}
 
-	logResponse("getDefaultRouteTable", resp)
-
-	for _, i := range resp.RouteTableList {
+	for _, i := range routeTableList {
 		if *i.IsDefault && *i.SupportedSubnetType.Code == "PRIVATE" {
 			privateRouteTableNo = *i.RouteTableNo
 		} else if *i.IsDefault && *i.SupportedSubnetType.Code == "PUBLIC" {
@@ -229,7 +251,7 @@ func getDefaultRouteTable(config *ProviderConfig, id string) (publicRouteTableNo
 	return publicRouteTableNo, privateRouteTableNo, nil
 }
 
-func resourceNcloudVpcDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteVpcRequest{
@@ -241,18 +263,18 @@ func resourceNcloudVpcDelete(d *schema.ResourceData, meta interface{}) error {
 	resp, err := config.Client.vpc.V2Api.DeleteVpc(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteVpc Vpc Instance", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 	logResponse("DeleteVpc", resp)
 
-	if err := waitForNcloudVpcDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudVpcDeletion(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudVpcCreation(config *ProviderConfig, id string) error {
+func waitForNcloudVpcCreation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
@@ -261,18 +283,18 @@ func waitForNcloudVpcCreation(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "VpcStatus")
 		},
 		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudVpcDeletion(config *ProviderConfig, id string) error {
+func waitForNcloudVpcDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
@@ -281,11 +303,11 @@ func waitForNcloudVpcDeletion(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "VpcStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC (%s) to become termintaing: %s", id, err)
 	}
 
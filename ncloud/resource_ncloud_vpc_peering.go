@@ -1,12 +1,13 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudVpcPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudVpcPeeringCreate,
-		Read:   resourceNcloudVpcPeeringRead,
-		Update: resourceNcloudVpcPeeringUpdate,
-		Delete: resourceNcloudVpcPeeringDelete,
+		CreateContext: resourceNcloudVpcPeeringCreate,
+		ReadContext:   resourceNcloudVpcPeeringRead,
+		UpdateContext: resourceNcloudVpcPeeringUpdate,
+		DeleteContext: resourceNcloudVpcPeeringDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -77,11 +78,11 @@ func resourceNcloudVpcPeering() *schema.Resource {
 	}
 }
 
-func resourceNcloudVpcPeeringCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_vpc_peering`")
+		return diagFromErr(NotSupportClassic("resource `ncloud_vpc_peering`"))
 	}
 
 	reqParams := &vpc.CreateVpcPeeringInstanceRequest{
@@ -110,7 +111,7 @@ func resourceNcloudVpcPeeringCreate(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.CreateVpcPeeringInstance(reqParams)
 	if err != nil {
 		logErrorResponse("CreateVpcPeeringInstance", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("CreateVpcPeeringInstance", resp)
@@ -119,20 +120,20 @@ func resourceNcloudVpcPeeringCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(*instance.VpcPeeringInstanceNo)
 	log.Printf("[INFO] VPC Peering ID: %s", d.Id())
 
-	if err := waitForNcloudVpcPeeringCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudVpcPeeringCreation(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
-	return resourceNcloudVpcPeeringRead(d, meta)
+	return resourceNcloudVpcPeeringRead(ctx, d, meta)
 }
 
-func resourceNcloudVpcPeeringRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcPeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getVpcPeeringInstance(config, d.Id())
 	if err != nil {
 		d.SetId("")
-		return err
+		return diagFromErr(err)
 	}
 
 	if instance == nil {
@@ -154,19 +155,19 @@ func resourceNcloudVpcPeeringRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudVpcPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("description") {
 		if err := setVpcPeeringDescription(d, config); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceNcloudVpcPeeringRead(d, meta)
+	return resourceNcloudVpcPeeringRead(ctx, d, meta)
 }
 
-func resourceNcloudVpcPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcPeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteVpcPeeringInstanceRequest{
@@ -178,19 +179,19 @@ func resourceNcloudVpcPeeringDelete(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.DeleteVpcPeeringInstance(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteVpcPeeringInstance", err, reqParams)
-		return err
+		return diagFromErr(err)
 	}
 
 	logResponse("DeleteVpcPeeringInstance", resp)
 
-	if err := waitForNcloudVpcPeeringDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudVpcPeeringDeletion(ctx, config, d.Id()); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudVpcPeeringCreation(config *ProviderConfig, id string) error {
+func waitForNcloudVpcPeeringCreation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
@@ -199,18 +200,18 @@ func waitForNcloudVpcPeeringCreation(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "VpcPeeringInstanceStatus")
 		},
 		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC Peering (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudVpcPeeringDeletion(config *ProviderConfig, id string) error {
+func waitForNcloudVpcPeeringDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
@@ -219,11 +220,11 @@ func waitForNcloudVpcPeeringDeletion(config *ProviderConfig, id string) error {
 			return VpcCommonStateRefreshFunc(instance, err, "VpcPeeringInstanceStatus")
 		},
 		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Delay:      waiterDelay,
+		MinTimeout: waiterMinTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC Peering (%s) to become termintaing: %s", id, err)
 	}
 
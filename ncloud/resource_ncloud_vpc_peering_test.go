@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -229,7 +230,7 @@ func testAccCheckVpcPeeringDisappears(instance *vpc.VpcPeeringInstance) resource
 
 		_, err := config.Client.vpc.V2Api.DeleteVpcPeeringInstance(reqParams)
 
-		if err := waitForNcloudVpcPeeringDeletion(config, *instance.VpcPeeringInstanceNo); err != nil {
+		if err := waitForNcloudVpcPeeringDeletion(context.Background(), config, *instance.VpcPeeringInstanceNo); err != nil {
 			return err
 		}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -153,10 +154,46 @@ func testAccCheckVpcDisappears(instance *vpc.Vpc) resource.TestCheckFunc {
 
 		_, err := config.Client.vpc.V2Api.DeleteVpc(reqParams)
 
-		if err := waitForNcloudVpcDeletion(config, *instance.VpcNo); err != nil {
+		if err := waitForNcloudVpcDeletion(context.Background(), config, *instance.VpcNo); err != nil {
 			return err
 		}
 
 		return err
 	}
 }
+
+func TestResourceNcloudVpc_emulator(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEmulator(t)
+	p, config := testEmulatorProvider(t, e, true)
+	r := p.ResourcesMap["ncloud_vpc"]
+
+	state := testEmulatorApply(t, r, nil, map[string]interface{}{
+		"name":            "tf-emulator-vpc",
+		"ipv4_cidr_block": "10.0.0.0/16",
+	}, config)
+
+	testCheckEmulatorState(t, state, map[string]string{
+		"name":            "tf-emulator-vpc",
+		"ipv4_cidr_block": "10.0.0.0/16",
+		"vpc_no":          state.ID,
+	})
+	for _, k := range []string{"default_network_acl_no", "default_access_control_group_no", "default_public_route_table_no", "default_private_route_table_no"} {
+		if state.Attributes[k] == "" {
+			t.Errorf("Expected %s to be set", k)
+		}
+	}
+
+	imported := testEmulatorImport(t, r, state.ID, config)
+	testCheckEmulatorState(t, imported, map[string]string{
+		"name":                   "tf-emulator-vpc",
+		"default_network_acl_no": state.Attributes["default_network_acl_no"],
+	})
+
+	testEmulatorDestroy(t, r, state, config)
+
+	if instance, err := getVpcInstance(config, state.ID); err != nil || instance != nil {
+		t.Fatalf("Expected VPC to be deleted, got %v, %v", instance, err)
+	}
+}
@@ -0,0 +1,172 @@
+package ncloud
+
+import (
+	"encoding/json"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud/credentials"
+)
+
+const (
+	DefaultMaxRetries      = 5
+	DefaultRetryMaxBackoff = 30 * time.Second
+
+	retryMinBackoff = 1 * time.Second
+)
+
+// retryableReturnCodes is the registry of return codes which are worth retrying for each service.
+// These codes mean the request was rejected without any change, so it is safe to send it again.
+var retryableReturnCodes = map[string][]string{
+	"server": {
+		ApiErrorObjectInOperation,
+		ApiErrorPortForwardingObjectInOperation,
+		ApiErrorServerObjectInOperation,
+		ApiErrorServerObjectInOperation2,
+		ApiErrorPreviousServersHaveNotBeenEntirelyTerminated,
+	},
+	"loadbalancer": {
+		ApiErrorObjectInOperation,
+	},
+	"autoscaling": {
+		ApiErrorASGScalingIsActive,
+	},
+	"vserver": {
+		ApiErrorObjectInOperation,
+		ApiErrorServerObjectInOperation,
+		ApiErrorServerObjectInOperation2,
+		ApiErrorAcgCantChangeSameTime,
+	},
+	"vpc": {
+		ApiErrorNetworkAclCantAccessaApropriate,
+		ApiErrorNetworkAclRuleChangeIngRules,
+	},
+	"vautoscaling": {
+		ApiErrorASGScalingIsActive,
+	},
+}
+
+// retryTransport sends the request again with exponential backoff and jitter
+// when the API answers with a retryable return code, 429 Too Many Requests or 5xx.
+type retryTransport struct {
+	transport   http.RoundTripper
+	service     string
+	credentials *credentials.Credentials
+	maxRetries  int
+	maxBackoff  time.Duration
+}
+
+func newRetryTransport(transport http.RoundTripper, service string, creds *credentials.Credentials, maxRetries int, maxBackoff time.Duration) *retryTransport {
+	return &retryTransport{
+		transport:   transport,
+		service:     service,
+		credentials: creds,
+		maxRetries:  maxRetries,
+		maxBackoff:  maxBackoff,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		r := newRequestWithBody(req, body)
+		if attempt > 0 {
+			signRequest(r, t.credentials)
+		}
+
+		resp, err := t.transport.RoundTrip(r)
+		if err != nil || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		retryable, reason := t.isRetryableResponse(req, resp)
+		if !retryable {
+			return resp, nil
+		}
+
+		backoff := retryAfter(resp)
+		if backoff == 0 {
+			backoff = retryBackoff(attempt, t.maxBackoff)
+		}
+		resp.Body.Close()
+
+		log.Printf("[WARN] %s %s: %s. retrying in %s (%d/%d)", t.service, apiOperationName(req), reason, backoff, attempt+1, t.maxRetries)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (t *retryTransport) isRetryableResponse(req *http.Request, resp *http.Response) (bool, string) {
+	switch {
+	case resp.StatusCode < 400:
+		return false, ""
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable:
+		return true, resp.Status
+	case resp.StatusCode >= 500:
+		// The request may have been processed, so only reads are safe to send again
+		return isReadOperation(req), resp.Status
+	}
+
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return false, ""
+	}
+
+	if returnCode := parseReturnCode(body); isRetryableReturnCode(t.service, returnCode) {
+		return true, "return code " + returnCode
+	}
+
+	return false, ""
+}
+
+func isRetryableReturnCode(service string, returnCode string) bool {
+	return returnCode != "" && containsInStringList(returnCode, retryableReturnCodes[service])
+}
+
+// parseReturnCode returns the return code of the error response body. e.g. {"responseError": {"returnCode": "25013", ...}}
+func parseReturnCode(body []byte) string {
+	var errBody struct {
+		ResponseError *CommonResponse `json:"responseError"`
+	}
+
+	if err := json.Unmarshal(body, &errBody); err != nil || errBody.ResponseError == nil {
+		return ""
+	}
+
+	return StringOrEmpty(errBody.ResponseError.ReturnCode)
+}
+
+// retryAfter returns the delay of the Retry-After header in seconds
+func retryAfter(resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return 0
+}
+
+// retryBackoff returns the exponential backoff of the attempt with equal jitter, capped at maxBackoff
+func retryBackoff(attempt int, maxBackoff time.Duration) time.Duration {
+	backoff := maxBackoff
+	if attempt < 30 && retryMinBackoff<<uint(attempt) < maxBackoff {
+		backoff = retryMinBackoff << uint(attempt)
+	}
+
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
@@ -0,0 +1,131 @@
+package ncloud
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRetryServer(t *testing.T, handler func(attempt int, w http.ResponseWriter, r *http.Request)) (*httptest.Server, *int) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		handler(attempts, w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func testRetryClient(service string) *http.Client {
+	return &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, service, nil, 3, time.Millisecond),
+	}
+}
+
+func TestRetryTransport_returnCode(t *testing.T) {
+	server, attempts := testRetryServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "responseFormatType=json&serverInstanceNo=1" {
+			t.Errorf("Request body must be sent again, got %s", body)
+		}
+
+		if attempt < 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"responseError":{"returnCode":"25013","returnMessage":"object in operation"}}`))
+			return
+		}
+		w.Write([]byte(`{"stopServerInstancesResponse":{"returnCode":"0"}}`))
+	})
+
+	resp, err := testRetryClient("server").Post(server.URL+"/server/v2/stopServerInstances", "application/x-www-form-urlencoded", strings.NewReader("responseFormatType=json&serverInstanceNo=1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusOK || *attempts != 3 {
+		t.Fatalf("Expected success after 3 attempts, got status %d after %d attempts", resp.StatusCode, *attempts)
+	}
+}
+
+func TestRetryTransport_maxRetries(t *testing.T) {
+	server, attempts := testRetryServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	resp, err := testRetryClient("vserver").Post(server.URL+"/vserver/v2/getServerInstanceList", "application/x-www-form-urlencoded", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests || *attempts != 4 {
+		t.Fatalf("Expected 429 after 4 attempts, got status %d after %d attempts", resp.StatusCode, *attempts)
+	}
+}
+
+func TestRetryTransport_nonRetryable(t *testing.T) {
+	server, attempts := testRetryServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "createServerInstances") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"responseError":{"returnCode":"25013","returnMessage":"object in operation"}}`))
+	})
+
+	// 500 of a mutating call is not retried because the request may have been processed
+	if _, err := testRetryClient("vserver").Post(server.URL+"/vserver/v2/createServerInstances", "application/x-www-form-urlencoded", nil); err != nil {
+		t.Fatal(err)
+	}
+	if *attempts != 1 {
+		t.Fatalf("Expected 1 attempt for 500 of mutating call, got %d", *attempts)
+	}
+
+	// 25013 is not registered for vpc
+	resp, err := testRetryClient("vpc").Post(server.URL+"/vpc/v2/createVpc", "application/x-www-form-urlencoded", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *attempts != 2 {
+		t.Fatalf("Expected 1 attempt for non retryable return code, got %d", *attempts-1)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "25013") {
+		t.Fatalf("Response body must be kept for the SDK, got %s", body)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	for attempt := 0; attempt < 40; attempt++ {
+		backoff := retryBackoff(attempt, 30*time.Second)
+		if backoff < 0 || backoff > 30*time.Second {
+			t.Fatalf("Backoff must be between 0 and max backoff, got %s for attempt %d", backoff, attempt)
+		}
+	}
+
+	if backoff := retryBackoff(0, 30*time.Second); backoff < retryMinBackoff/2 || backoff > retryMinBackoff {
+		t.Fatalf("First backoff must be between %s and %s, got %s", retryMinBackoff/2, retryMinBackoff, backoff)
+	}
+}
+
+func TestIsReadOperation(t *testing.T) {
+	cases := map[string]bool{
+		"POST https://ncloud.apigw.ntruss.com/vserver/v2/getServerInstanceList": true,
+		"POST https://ncloud.apigw.ntruss.com/vserver/v2/createServerInstances": false,
+		"GET https://nks.apigw.ntruss.com/vnks/v2/clusters":                     true,
+		"POST https://nks.apigw.ntruss.com/vnks/v2/clusters":                    false,
+		"DELETE https://nks.apigw.ntruss.com/vnks/v2/clusters/uuid":             false,
+	}
+
+	for c, expected := range cases {
+		s := strings.Split(c, " ")
+		req, _ := http.NewRequest(s[0], s[1], nil)
+		if isReadOperation(req) != expected {
+			t.Fatalf("Expected %t for %s", expected, c)
+		}
+	}
+}
@@ -1,13 +1,241 @@
 package ncloud
 
 import (
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"fmt"
+	"reflect"
 	"sort"
+	"strconv"
 	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultDateFormat is the date format which the APIs return. e.g. 2021-01-02T15:04:05+0900
+var defaultDateFormat = "2006-01-02T15:04:05-0700"
+
+// dateFormats are the date formats parsed by parseDate, for the dates written by users. e.g. filter values
+var dateFormats = []string{
+	defaultDateFormat,
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+const (
+	sortOrderAsc  = "asc"
+	sortOrderDesc = "desc"
 )
 
-var defaultDateFormat = "2006-01-02T15:04:00+0900"
+// parseDate parses the date of the APIs or of the dateFormats
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range dateFormats {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// lessCreateDate compares the create dates of the APIs. A date which can't be parsed is older than any other
+func lessCreateDate(a, b *string) bool {
+	aTime, _ := parseDate(ncloud.StringValue(a))
+	bTime, _ := parseDate(ncloud.StringValue(b))
+
+	return aTime.Before(bTime)
+}
+
+func dataSourceMostRecentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"sort_by"},
+		Description:   "Sort the results from the newest by `create_date`. A singular data source selects the newest one when more than one is found.",
+	}
+}
+
+func dataSourceSortBySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"order": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          sortOrderAsc,
+					ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{sortOrderAsc, sortOrderDesc}, false)),
+				},
+			},
+		},
+		Description: "Sort the results by the field. A singular data source selects the first one when more than one is found.",
+	}
+}
+
+// setDataSourceSortSchema adds `most_recent` and `sort_by` to the singular data source, which selects one of the results by them
+func setDataSourceSortSchema(s map[string]*schema.Schema) {
+	if _, ok := s["most_recent"]; !ok {
+		s["most_recent"] = dataSourceMostRecentSchema()
+	}
+	if _, ok := s["sort_by"]; !ok {
+		s["sort_by"] = dataSourceSortBySchema()
+	}
+}
+
+// ApplySort sorts the items by `sort_by`, or from the newest by `create_date` with `most_recent`
+func ApplySort(d *schema.ResourceData, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) ([]map[string]interface{}, error) {
+	var field, order string
+
+	if v, ok := d.GetOk("sort_by"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		sortBy := v.([]interface{})[0].(map[string]interface{})
+		field, order = sortBy["field"].(string), sortBy["order"].(string)
+	} else if v, ok := d.GetOk("most_recent"); ok && v.(bool) {
+		if _, ok := resourceSchema["create_date"]; !ok {
+			return nil, fmt.Errorf("`most_recent` is not supported by this data source without `create_date`. use `sort_by` instead")
+		}
+		field, order = "create_date", sortOrderDesc
+	} else {
+		return items, nil
+	}
+
+	pathElements, err := getFieldPathElements(resourceSchema, field)
+	if err != nil {
+		return nil, fmt.Errorf("invalid `sort_by` field %q: %s", field, err)
+	}
+
+	sorted := make([]map[string]interface{}, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, aOk := getValueFromPath(sorted[i], pathElements)
+		b, bOk := getValueFromPath(sorted[j], pathElements)
+		// Items without the field come last in either order
+		if !aOk || a == nil || !bOk || b == nil {
+			return (aOk && a != nil) && !(bOk && b != nil)
+		}
+
+		if order == sortOrderDesc {
+			return compareSortValues(b, a) < 0
+		}
+		return compareSortValues(a, b) < 0
+	})
+
+	return sorted, nil
+}
+
+// ApplySingularSort sorts the items in the same way as ApplySort, and selects the first one if sorted,
+// so that a singular data source doesn't fail when more than one is found.
+func ApplySingularSort(d *schema.ResourceData, items []map[string]interface{}, resourceSchema map[string]*schema.Schema) ([]map[string]interface{}, error) {
+	sorted, err := ApplySort(d, items, resourceSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sorted) > 1 && isSortRequested(d) {
+		return sorted[:1], nil
+	}
+
+	return sorted, nil
+}
+
+func isSortRequested(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("sort_by"); ok && len(v.([]interface{})) > 0 {
+		return true
+	}
+
+	v, ok := d.GetOk("most_recent")
+	return ok && v.(bool)
+}
+
+// compareSortValues compares numbers and bools by value, and strings as dates, sizes, or in the natural order.
+// e.g. "8GB" < "16GB", "1.9.2" < "1.10.0"
+func compareSortValues(a, b interface{}) int {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	if isNumberKind(av.Kind()) && isNumberKind(bv.Kind()) {
+		return compareFilterFloats(sortNumber(av), sortNumber(bv))
+	}
+
+	if av.Kind() == reflect.Bool && bv.Kind() == reflect.Bool {
+		return compareFilterFloats(sortNumber(av), sortNumber(bv))
+	}
+
+	as, bs := filterScalarString(av), filterScalarString(bv)
+	if cmp, ok := compareFilterStrings(as, bs); ok {
+		return cmp
+	}
+
+	return compareNatural(as, bs)
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func sortNumber(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+	}
+	return 0
+}
+
+// compareNatural compares the strings in the natural order, where the digits are compared as numbers
+func compareNatural(a, b string) int {
+	for a != "" && b != "" {
+		aChunk, aNumber := naturalChunk(a)
+		bChunk, bNumber := naturalChunk(b)
+		a, b = a[len(aChunk):], b[len(bChunk):]
+
+		if aNumber && bNumber {
+			an, _ := strconv.ParseFloat(aChunk, 64)
+			bn, _ := strconv.ParseFloat(bChunk, 64)
+			if cmp := compareFilterFloats(an, bn); cmp != 0 {
+				return cmp
+			}
+			continue
+		}
+
+		if aChunk != bChunk {
+			if aChunk < bChunk {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return compareFilterFloats(float64(len(a)), float64(len(b)))
+}
+
+// naturalChunk returns the leading run of digits or of non-digits
+func naturalChunk(s string) (string, bool) {
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+
+	number := isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == number {
+		i++
+	}
+
+	return s[:i], number
+}
 
 type memberServerImageSort []*server.MemberServerImage
 
@@ -18,10 +246,7 @@ func (a memberServerImageSort) Swap(i, j int) {
 	a[i], a[j] = a[j], a[i]
 }
 func (a memberServerImageSort) Less(i, j int) bool {
-	iTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[i].CreateDate))
-	jTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[j].CreateDate))
-
-	return iTime.Unix() < jTime.Unix()
+	return lessCreateDate(a[i].CreateDate, a[j].CreateDate)
 }
 
 func mostRecentMemberServerImage(images []*server.MemberServerImage) *server.MemberServerImage {
@@ -39,9 +264,7 @@ func (a acgSort) Swap(i, j int) {
 	a[i], a[j] = a[j], a[i]
 }
 func (a acgSort) Less(i, j int) bool {
-	iTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[i].CreateDate))
-	jTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[j].CreateDate))
-	return iTime.Unix() < jTime.Unix()
+	return lessCreateDate(a[i].CreateDate, a[j].CreateDate)
 }
 
 func mostRecentAccessControlGroup(acgs []*server.AccessControlGroup) *server.AccessControlGroup {
@@ -59,9 +282,7 @@ func (a publicIPSort) Swap(i, j int) {
 	a[i], a[j] = a[j], a[i]
 }
 func (a publicIPSort) Less(i, j int) bool {
-	iTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[i].CreateDate))
-	jTime, _ := time.Parse(defaultDateFormat, ncloud.StringValue(a[j].CreateDate))
-	return iTime.Unix() < jTime.Unix()
+	return lessCreateDate(a[i].CreateDate, a[j].CreateDate)
 }
 
 func mostRecentPublicIp(publicIPs []*server.PublicIpInstance) *server.PublicIpInstance {
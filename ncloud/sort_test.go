@@ -3,6 +3,7 @@ package ncloud
 import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"testing"
 )
 
@@ -44,3 +45,91 @@ func TestMostRecentPublicIp(t *testing.T) {
 		t.Fatalf("Expected: %s, Actual: %s", recentDate, *mostRecent.CreateDate)
 	}
 }
+
+func testSortResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	s := map[string]*schema.Schema{}
+	for k, v := range testFilterSchema {
+		s[k] = v
+	}
+	setDataSourceSortSchema(s)
+
+	return schema.TestResourceDataRaw(t, s, raw)
+}
+
+func TestApplySort(t *testing.T) {
+	items := append(testFilterItems(),
+		map[string]interface{}{"id": "4", "product_code": "SVR.VSVR.STAND.C002.M004.NET.SSD.B050.G002", "cpu_count": 2, "memory_size": "4GB"},
+	)
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{"not sorted", map[string]interface{}{}, "1,2,3,4"},
+		{"number desc", map[string]interface{}{"sort_by": []interface{}{map[string]interface{}{"field": "cpu_count", "order": "desc"}}}, "3,2,1,4"},
+		{"size asc", map[string]interface{}{"sort_by": []interface{}{map[string]interface{}{"field": "memory_size"}}}, "4,1,2,3"},
+		{"missing field last", map[string]interface{}{"sort_by": []interface{}{map[string]interface{}{"field": "create_date", "order": "asc"}}}, "1,2,3,4"},
+		{"most_recent", map[string]interface{}{"most_recent": true}, "3,2,1,4"},
+	}
+
+	for _, tc := range cases {
+		sorted, err := ApplySort(testSortResourceData(t, tc.raw), items, testFilterSchema)
+		if err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+			continue
+		}
+
+		if actual := testFilterIds(sorted); actual != tc.expected {
+			t.Errorf("%s: expected %s, actual %s", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func TestApplySort_invalid(t *testing.T) {
+	d := testSortResourceData(t, map[string]interface{}{"sort_by": []interface{}{map[string]interface{}{"field": "unknown"}}})
+	if _, err := ApplySort(d, testFilterItems(), testFilterSchema); err == nil {
+		t.Fatal("expected an error for the unknown field")
+	}
+
+	d = testSortResourceData(t, map[string]interface{}{"most_recent": true})
+	withoutDate := map[string]*schema.Schema{"id": {Type: schema.TypeString}}
+	if _, err := ApplySort(d, testFilterItems(), withoutDate); err == nil {
+		t.Fatal("expected an error for most_recent without create_date")
+	}
+}
+
+func TestApplySingularSort(t *testing.T) {
+	d := testSortResourceData(t, map[string]interface{}{"most_recent": true})
+	items, err := ApplySingularSort(d, testFilterItems(), testFilterSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual := testFilterIds(items); actual != "3" {
+		t.Fatalf("Expected: 3, Actual: %s", actual)
+	}
+
+	d = testSortResourceData(t, map[string]interface{}{})
+	if items, _ := ApplySingularSort(d, testFilterItems(), testFilterSchema); len(items) != 3 {
+		t.Fatalf("Expected all of the items without sort, Actual: %d", len(items))
+	}
+}
+
+func TestCompareNatural(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.9.2", "1.10.0", -1},
+		{"1.20.13-nks.1", "1.20.13-nks.1", 0},
+		{"1.21.9", "1.21", 1},
+		{"centos-7.8", "centos-7.3", 1},
+		{"ubuntu", "centos", 1},
+	}
+
+	for _, tc := range cases {
+		if actual := compareNatural(tc.a, tc.b); actual != tc.expected {
+			t.Errorf("compareNatural(%q, %q): expected %d, actual %d", tc.a, tc.b, tc.expected, actual)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package ncloud
 import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"reflect"
+	"sort"
 	"strconv"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -284,6 +285,159 @@ func flattenInstanceTagList(tagList []*server.InstanceTag) []map[string]interfac
 	return list
 }
 
+// mergeDefaultTags merges the provider default tags and the tag list of the resource. Tags of the resource take precedence.
+func mergeDefaultTags(defaultTags map[string]string, tl []interface{}) map[string]string {
+	tags := make(map[string]string, len(defaultTags)+len(tl))
+
+	for k, v := range defaultTags {
+		tags[k] = v
+	}
+
+	for _, v := range tl {
+		if m, ok := v.(map[string]interface{}); ok {
+			tags[m["tag_key"].(string)] = m["tag_value"].(string)
+		}
+	}
+
+	return tags
+}
+
+// expandTagMapToTagList converts the tag map to the `tag_list` form sorted by key
+func expandTagMapToTagList(tags map[string]string) []interface{} {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tl := make([]interface{}, 0, len(tags))
+	for _, k := range keys {
+		tl = append(tl, map[string]interface{}{
+			"tag_key":   k,
+			"tag_value": tags[k],
+		})
+	}
+
+	return tl
+}
+
+// diffInstanceTags returns the tags to delete and the tags to create. A tag whose value changed is deleted and created again.
+func diffInstanceTags(oldTags, newTags map[string]string) (map[string]string, map[string]string) {
+	removeTags := make(map[string]string)
+	addTags := make(map[string]string)
+
+	for k, v := range oldTags {
+		if nv, ok := newTags[k]; !ok || nv != v {
+			removeTags[k] = v
+		}
+	}
+
+	for k, v := range newTags {
+		if ov, ok := oldTags[k]; !ok || ov != v {
+			addTags[k] = v
+		}
+	}
+
+	return removeTags, addTags
+}
+
+func expandStringMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+
+	return result
+}
+
+func flattenInstanceTagMap(tagList []*server.InstanceTag) map[string]string {
+	tags := make(map[string]string, len(tagList))
+
+	for _, r := range tagList {
+		tags[ncloud.StringValue(r.TagKey)] = ncloud.StringValue(r.TagValue)
+	}
+
+	return tags
+}
+
+func flattenStringList(list []*string) []interface{} {
+	result := make([]interface{}, 0, len(list))
+
+	for _, v := range list {
+		result = append(result, ncloud.StringValue(v))
+	}
+
+	return result
+}
+
+// expandNetworkInterfaceOrders returns the order of each network interface of the `network_interface` list
+func expandNetworkInterfaceOrders(list []interface{}) map[string]int {
+	orders := make(map[string]int, len(list))
+
+	for _, v := range list {
+		m := v.(map[string]interface{})
+		orders[m["network_interface_no"].(string)] = m["order"].(int)
+	}
+
+	return orders
+}
+
+func primaryNetworkInterfaceNo(list []interface{}) string {
+	for no, order := range expandNetworkInterfaceOrders(list) {
+		if order == 0 {
+			return no
+		}
+	}
+
+	return ""
+}
+
+// isNetworkInterfaceReplaced returns whether the primary network interface or the order of an attached network interface changed.
+// Both can't be changed without replacing the server.
+func isNetworkInterfaceReplaced(oldOrders, newOrders map[string]int) bool {
+	for no, order := range newOrders {
+		oldOrder, ok := oldOrders[no]
+		if ok && oldOrder != order {
+			return true
+		}
+
+		if !ok && order == 0 {
+			return true
+		}
+	}
+
+	for no, order := range oldOrders {
+		if _, ok := newOrders[no]; !ok && order == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffNetworkInterfaces returns the network interfaces to detach and the network interfaces to attach in the ascending order
+func diffNetworkInterfaces(oldOrders, newOrders map[string]int) ([]string, []string) {
+	var detachList, attachList []string
+
+	for no := range oldOrders {
+		if _, ok := newOrders[no]; !ok {
+			detachList = append(detachList, no)
+		}
+	}
+
+	for no := range newOrders {
+		if _, ok := oldOrders[no]; !ok {
+			attachList = append(attachList, no)
+		}
+	}
+
+	sort.Slice(detachList, func(i, j int) bool { return oldOrders[detachList[i]] < oldOrders[detachList[j]] })
+	sort.Slice(attachList, func(i, j int) bool { return newOrders[attachList[i]] < newOrders[attachList[j]] })
+
+	return detachList, attachList
+}
+
 func flattenMapByKey(i interface{}, key string) *string {
 	m := ConvertToMap(i)
 	if m[key] != nil {
@@ -554,6 +554,88 @@ func TestExpandTagListParams(t *testing.T) {
 	}
 }
 
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"owner":       "infra",
+		"cost-center": "1234",
+	}
+	tagList := []interface{}{
+		map[string]interface{}{
+			"tag_key":   "owner",
+			"tag_value": "web",
+		},
+		map[string]interface{}{
+			"tag_key":   "env",
+			"tag_value": "prod",
+		},
+	}
+
+	result := mergeDefaultTags(defaultTags, tagList)
+	expected := map[string]string{
+		"owner":       "web",
+		"cost-center": "1234",
+		"env":         "prod",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %v, but got %v", expected, result)
+	}
+}
+
+func TestExpandTagMapToTagList(t *testing.T) {
+	result := expandTagMapToTagList(map[string]string{
+		"prod": "auth",
+		"dev":  "web",
+	})
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"tag_key":   "dev",
+			"tag_value": "web",
+		},
+		map[string]interface{}{
+			"tag_key":   "prod",
+			"tag_value": "auth",
+		},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %v, but got %v", expected, result)
+	}
+}
+
+func TestDiffInstanceTags(t *testing.T) {
+	oldTags := map[string]string{
+		"owner": "infra",
+		"env":   "dev",
+		"team":  "web",
+	}
+	newTags := map[string]string{
+		"owner": "infra",
+		"env":   "prod",
+		"app":   "api",
+	}
+
+	removeTags, addTags := diffInstanceTags(oldTags, newTags)
+
+	expectedRemoveTags := map[string]string{
+		"env":  "dev",
+		"team": "web",
+	}
+	expectedAddTags := map[string]string{
+		"env": "prod",
+		"app": "api",
+	}
+
+	if !reflect.DeepEqual(removeTags, expectedRemoveTags) {
+		t.Fatalf("expected remove tags %v, but got %v", expectedRemoveTags, removeTags)
+	}
+
+	if !reflect.DeepEqual(addTags, expectedAddTags) {
+		t.Fatalf("expected add tags %v, but got %v", expectedAddTags, addTags)
+	}
+}
+
 func TestFlattenInstanceTagList(t *testing.T) {
 	expanded := []*server.InstanceTag{
 		{
@@ -787,3 +869,40 @@ func TestExpandNKSNodePoolAutoScale(t *testing.T) {
 		t.Fatalf("expected result 2, but got %d", ncloud.Int32Value(result.Max))
 	}
 }
+
+func TestIsNetworkInterfaceReplaced(t *testing.T) {
+	old := map[string]int{"1": 0, "2": 1}
+
+	cases := []struct {
+		new      map[string]int
+		expected bool
+	}{
+		{map[string]int{"1": 0, "2": 1, "3": 2}, false},
+		{map[string]int{"1": 0}, false},
+		{map[string]int{"1": 0, "3": 1}, false},
+		{map[string]int{"4": 0, "2": 1}, true},
+		{map[string]int{"2": 1}, true},
+		{map[string]int{"1": 0, "2": 2}, true},
+	}
+
+	for _, c := range cases {
+		if result := isNetworkInterfaceReplaced(old, c.new); result != c.expected {
+			t.Fatalf("Expected %t for %v, got %t", c.expected, c.new, result)
+		}
+	}
+}
+
+func TestDiffNetworkInterfaces(t *testing.T) {
+	old := map[string]int{"1": 0, "2": 1, "3": 2}
+	new := map[string]int{"1": 0, "5": 2, "4": 1}
+
+	detachList, attachList := diffNetworkInterfaces(old, new)
+
+	if !reflect.DeepEqual(detachList, []string{"2", "3"}) {
+		t.Fatalf("Expected detach list [2 3], got %v", detachList)
+	}
+
+	if !reflect.DeepEqual(attachList, []string{"4", "5"}) {
+		t.Fatalf("Expected attach list in the order [4 5], got %v", attachList)
+	}
+}
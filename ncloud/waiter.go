@@ -0,0 +1,120 @@
+package ncloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The delays of the status waiters and the sleep before retrying a call rejected while the instance is busy.
+// They are variables so that the emulator tests poll without sleeping through the delays for the real API.
+var (
+	waiterDelay      = 2 * time.Second
+	waiterMinTimeout = 3 * time.Second
+	retryDelay       = 5 * time.Second
+)
+
+// statusWaiter polls an instance until its status reaches one of Target.
+// Name and Field only describe what is watched, e.g. "ServerInstance" and "state".
+type statusWaiter struct {
+	Name    string
+	ID      string
+	Field   string
+	Pending []string
+	Target  []string
+	Refresh resource.StateRefreshFunc
+
+	// Timeout is usually d.Timeout(...) of the calling resource.
+	// If zero, the deadline of the context or DefaultTimeout is used.
+	Timeout time.Duration
+
+	Delay      time.Duration
+	MinTimeout time.Duration
+}
+
+// Wait blocks until the target status is reached, the timeout expires or ctx is cancelled.
+func (w *statusWaiter) Wait(ctx context.Context) (interface{}, error) {
+	var mu sync.Mutex
+	var lastStatus string
+
+	stateConf := &resource.StateChangeConf{
+		Pending: w.Pending,
+		Target:  w.Target,
+		Refresh: func() (interface{}, string, error) {
+			result, status, err := w.Refresh()
+			if err == nil {
+				mu.Lock()
+				lastStatus = status
+				mu.Unlock()
+			}
+			return result, status, err
+		},
+		Timeout:    w.timeout(ctx),
+		Delay:      w.Delay,
+		MinTimeout: w.MinTimeout,
+	}
+	if stateConf.Delay == 0 {
+		stateConf.Delay = waiterDelay
+	}
+	if stateConf.MinTimeout == 0 {
+		stateConf.MinTimeout = waiterMinTimeout
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		mu.Lock()
+		defer mu.Unlock()
+		return nil, &waitError{
+			Name:       w.Name,
+			ID:         w.ID,
+			Field:      w.Field,
+			Target:     w.Target,
+			LastStatus: lastStatus,
+			Err:        err,
+		}
+	}
+
+	return result, nil
+}
+
+func (w *statusWaiter) timeout(ctx context.Context) time.Duration {
+	if w.Timeout > 0 {
+		return w.Timeout
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return DefaultTimeout
+}
+
+// waitError is returned by statusWaiter.Wait with the last status seen before giving up.
+type waitError struct {
+	Name       string
+	ID         string
+	Field      string
+	Target     []string
+	LastStatus string
+	Err        error
+}
+
+func (e *waitError) Error() string {
+	field := e.Field
+	if field == "" {
+		field = "state"
+	}
+
+	msg := fmt.Sprintf("error waiting for %s (%s) %s to be %q", e.Name, e.ID, field, strings.Join(e.Target, ", "))
+	if e.LastStatus != "" {
+		msg += fmt.Sprintf(", last seen %q", e.LastStatus)
+	}
+
+	return fmt.Sprintf("%s: %s", msg, e.Err)
+}
+
+func (e *waitError) Unwrap() error {
+	return e.Err
+}
@@ -1,9 +1,8 @@
 package ncloud
 
 import (
-	"encoding/json"
 	"reflect"
-	"unicode"
+	"strings"
 )
 
 // VpcCommonStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch a instances
@@ -13,23 +12,30 @@ func VpcCommonStateRefreshFunc(instance interface{}, err error, statusName strin
 	}
 
 	if instance == nil || reflect.ValueOf(instance).IsNil() {
-		return instance, "TERMINATED", nil
+		return instance, InstanceStatusTerminated, nil
 	}
 
-	b, err := json.Marshal(instance)
-	if err != nil {
-		return nil, "", err
+	return instance, commonCodeField(instance, statusName), nil
+}
+
+// commonCodeField returns the code of the CommonCode typed field statusName of instance, matched case-insensitively.
+func commonCodeField(instance interface{}, statusName string) string {
+	v := reflect.Indirect(reflect.ValueOf(instance))
+	if v.Kind() != reflect.Struct {
+		return ""
 	}
-	var m map[string]interface{}
-	json.Unmarshal(b, &m)
 
-	a := []rune(statusName)
-	a[0] = unicode.ToLower(a[0])
-	statusName = string(a)
+	field := reflect.Indirect(v.FieldByNameFunc(func(name string) bool {
+		return strings.EqualFold(name, statusName)
+	}))
+	if !field.IsValid() || field.Kind() != reflect.Struct {
+		return ""
+	}
 
-	if u, ok := m[statusName].(map[string]interface{}); ok {
-		return instance, u["code"].(string), nil
+	code := reflect.Indirect(field.FieldByName("Code"))
+	if !code.IsValid() || code.Kind() != reflect.String {
+		return ""
 	}
 
-	return instance, "", nil
+	return code.String()
 }
@@ -32,3 +32,14 @@ func testVpcCommonStateRefreshFunc(instance interface{}, expected string, t *tes
 		t.Fatalf("Expected: %s, Actual: %s", expected, status)
 	}
 }
+
+func TestVpcCommonStateRefreshFunc_unknownStatus(t *testing.T) {
+	_, status, err := VpcCommonStateRefreshFunc(&vpc.RouteTable{}, nil, "RouteTableStatus")
+	if err != nil {
+		t.Fatal("Got Error")
+	}
+
+	if status != "" {
+		t.Fatalf("Expected empty status, Actual: %s", status)
+	}
+}
@@ -0,0 +1,117 @@
+package ncloud
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testStatusWaiter(refresh resource.StateRefreshFunc, timeout time.Duration) *statusWaiter {
+	return &statusWaiter{
+		Name:       "ServerInstance",
+		ID:         "1234",
+		Pending:    []string{InstanceStatusInit, InstanceStatusCreated},
+		Target:     []string{InstanceStatusRunning},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      time.Millisecond,
+		MinTimeout: time.Millisecond,
+	}
+}
+
+func TestStatusWaiter_target(t *testing.T) {
+	statuses := []string{InstanceStatusInit, InstanceStatusCreated, InstanceStatusRunning}
+	calls := 0
+
+	result, err := testStatusWaiter(func() (interface{}, string, error) {
+		status := statuses[calls]
+		calls++
+		return status, status, nil
+	}, time.Minute).Wait(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result != InstanceStatusRunning || calls != 3 {
+		t.Fatalf("expected %q after 3 refreshes, got %v after %d", InstanceStatusRunning, result, calls)
+	}
+}
+
+func TestStatusWaiter_timeout(t *testing.T) {
+	_, err := testStatusWaiter(func() (interface{}, string, error) {
+		return 0, InstanceStatusCreated, nil
+	}, 300*time.Millisecond).Wait(context.Background())
+
+	var waitErr *waitError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("expected waitError, got %#v", err)
+	}
+	if waitErr.LastStatus != InstanceStatusCreated {
+		t.Fatalf("expected last status %q, got %q", InstanceStatusCreated, waitErr.LastStatus)
+	}
+
+	var timeoutErr *resource.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected wrapped TimeoutError, got %#v", waitErr.Err)
+	}
+
+	expected := `error waiting for ServerInstance (1234) state to be "RUN", last seen "CREAT"`
+	if !strings.HasPrefix(err.Error(), expected) {
+		t.Fatalf("expected message to start with %s, got %s", expected, err)
+	}
+}
+
+func TestStatusWaiter_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+
+	_, err := testStatusWaiter(func() (interface{}, string, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return 0, InstanceStatusInit, nil
+	}, time.Hour).Wait(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %#v", err)
+	}
+
+	var waitErr *waitError
+	if !errors.As(err, &waitErr) || waitErr.LastStatus != InstanceStatusInit {
+		t.Fatalf("expected waitError with last status %q, got %#v", InstanceStatusInit, err)
+	}
+}
+
+func TestStatusWaiter_contextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := testStatusWaiter(func() (interface{}, string, error) {
+		return 0, InstanceStatusInit, nil
+	}, 0).Wait(ctx)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected the wait to stop at the context deadline, took %s", elapsed)
+	}
+}
+
+func TestStatusWaiter_refreshError(t *testing.T) {
+	apiErr := errors.New("api error")
+
+	_, err := testStatusWaiter(func() (interface{}, string, error) {
+		return nil, "", apiErr
+	}, time.Minute).Wait(context.Background())
+
+	if !errors.Is(err, apiErr) {
+		t.Fatalf("expected wrapped api error, got %#v", err)
+	}
+}
@@ -16,8 +16,6 @@ type Zone struct {
 	RegionCode      *string `json:"regionCode,omitempty"`
 }
 
-var zoneCache = make(map[string]string)
-
 func parseZoneNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if zoneCode, zoneCodeOk := d.GetOk("zone"); zoneCodeOk {
 		zoneNo := getZoneNoByCode(config, zoneCode.(string))
@@ -31,22 +29,22 @@ func parseZoneNoParameter(config *ProviderConfig, d *schema.ResourceData) (*stri
 }
 
 func getZoneNoByCode(config *ProviderConfig, code string) string {
-	if zoneNo := zoneCache[code]; zoneNo != "" {
+	if zoneNo := config.getZoneCache(code); zoneNo != "" {
 		return zoneNo
 	}
 	if zone, err := getZoneByCode(config, code); err == nil && zone != nil {
-		zoneCache[code] = *zone.ZoneNo
+		config.setZoneCache(code, *zone.ZoneNo)
 		return *zone.ZoneNo
 	}
 	return ""
 }
 
 func getZoneCodeByNo(config *ProviderConfig, no string) string {
-	if zoneCode := zoneCache[no]; zoneCode != "" {
+	if zoneCode := config.getZoneCache(no); zoneCode != "" {
 		return zoneCode
 	}
 	if zone, err := getZoneByNo(config, no); err == nil && zone != nil {
-		zoneCache[no] = *zone.ZoneCode
+		config.setZoneCache(no, *zone.ZoneCode)
 		return *zone.ZoneCode
 	}
 	return ""